@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccessAppTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareAccessAppTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"app_types": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Access application types available to the account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"application_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"saas_templates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The catalog of SaaS application templates with their default SAML attribute mappings, for seeding `cloudflare_access_application` SaaS configs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"saml_attributes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name_format": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Description: "Use this data source to look up the Access application types and the SaaS application template catalog.",
+	}
+}
+
+// accessAppType represents an entry of the Access application type catalog,
+// which the pinned client does not yet model.
+type accessAppType struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	ApplicationType string `json:"application_type"`
+}
+
+// accessSaaSTemplateAttribute is a single default SAML attribute mapping of a
+// SaaS application template.
+type accessSaaSTemplateAttribute struct {
+	Name       string
+	NameFormat string
+	SourceName string
+}
+
+const saasAttributeBasicFormat = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+
+// accessSaaSTemplates is the catalog of SaaS application templates and the
+// default SAML attribute mappings the dashboard seeds them with.
+var accessSaaSTemplates = map[string][]accessSaaSTemplateAttribute{
+	"aws": {
+		{Name: "https://aws.amazon.com/SAML/Attributes/RoleSessionName", NameFormat: saasAttributeBasicFormat, SourceName: "email"},
+		{Name: "https://aws.amazon.com/SAML/Attributes/Role", NameFormat: saasAttributeBasicFormat, SourceName: "groups"},
+	},
+	"salesforce": {
+		{Name: "email", NameFormat: saasAttributeBasicFormat, SourceName: "email"},
+	},
+	"servicenow": {
+		{Name: "email", NameFormat: saasAttributeBasicFormat, SourceName: "email"},
+	},
+	"slack": {
+		{Name: "User.Email", NameFormat: saasAttributeBasicFormat, SourceName: "email"},
+	},
+}
+
+func dataSourceCloudflareAccessAppTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/accounts/%s/access/app_types", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Access application types: %w", err))
+	}
+
+	var appTypes []accessAppType
+	if err := json.Unmarshal(res, &appTypes); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Access application types: %w", err))
+	}
+
+	flattenedAppTypes := make([]interface{}, 0, len(appTypes))
+	for _, appType := range appTypes {
+		flattenedAppTypes = append(flattenedAppTypes, map[string]interface{}{
+			"id":               appType.ID,
+			"name":             appType.Name,
+			"application_type": appType.ApplicationType,
+		})
+	}
+
+	if err := d.Set("app_types", flattenedAppTypes); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set app_types: %w", err))
+	}
+
+	templateNames := make([]string, 0, len(accessSaaSTemplates))
+	for name := range accessSaaSTemplates {
+		templateNames = append(templateNames, name)
+	}
+	sort.Strings(templateNames)
+
+	templates := make([]interface{}, 0, len(templateNames))
+	for _, name := range templateNames {
+		attributes := make([]interface{}, 0, len(accessSaaSTemplates[name]))
+		for _, attribute := range accessSaaSTemplates[name] {
+			attributes = append(attributes, map[string]interface{}{
+				"name":        attribute.Name,
+				"name_format": attribute.NameFormat,
+				"source_name": attribute.SourceName,
+			})
+		}
+		templates = append(templates, map[string]interface{}{
+			"name":            name,
+			"saml_attributes": attributes,
+		})
+	}
+
+	if err := d.Set("saas_templates", templates); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set saas_templates: %w", err))
+	}
+
+	d.SetId(stringChecksum(accountID))
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAccessAppTypes(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_access_app_types.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessAppTypesConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttrSet(name, "app_types.#"),
+					resource.TestCheckResourceAttrSet(name, "saas_templates.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccessAppTypesConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	data "cloudflare_access_app_types" "%[1]s" {
+		account_id = "%[2]s"
+	}`, name, accountID)
+}
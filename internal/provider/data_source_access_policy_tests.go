@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// accessPolicyTest is the API representation of an Access policy test, which
+// evaluates a policy against every user of the account.
+type accessPolicyTest struct {
+	ID                 string `json:"id"`
+	Status             string `json:"status"`
+	PercentUsersTested int    `json:"percent_users_tested"`
+	UsersApprovedCount int    `json:"users_approved_count"`
+	UsersBlockedCount  int    `json:"users_blocked_count"`
+	TotalUsersCount    int    `json:"total_users_count"`
+}
+
+// accessPolicyTestUser is one per-user result of an Access policy test.
+type accessPolicyTestUser struct {
+	Email  string `json:"email"`
+	Status string `json:"status"`
+}
+
+// accessPolicyTestTimeout bounds how long a policy test may stay in progress
+// before the read fails; data sources have no configurable timeouts.
+const accessPolicyTestTimeout = 5 * time.Minute
+
+func dataSourceCloudflareAccessPolicyTest() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareAccessPolicyTestRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The account identifier to target for the resource.",
+			},
+			"policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the Access policy to evaluate.",
+			},
+			"user_email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The email of the user to look up in the test results.",
+			},
+			"decision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Whether the policy would grant the user access: `approved` or `blocked`.",
+			},
+			"users_approved_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of users the policy would approve.",
+			},
+			"users_blocked_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of users the policy would block.",
+			},
+			"total_users_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of users the policy was evaluated against.",
+			},
+		},
+		Description: "Use this data source to evaluate an Access policy against a user before deploying it, e.g. to assert in CI that a policy change does not lock out administrators.",
+	}
+}
+
+func dataSourceCloudflareAccessPolicyTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	policyID := d.Get("policy_id").(string)
+	userEmail := d.Get("user_email").(string)
+
+	res, err := client.Raw(http.MethodPost, fmt.Sprintf("/accounts/%s/access/policy-tests", accountID), map[string]interface{}{
+		"policies": []string{policyID},
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error starting Access policy test for policy %q: %w", policyID, err))
+	}
+
+	var test accessPolicyTest
+	if err := json.Unmarshal(res, &test); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Access policy test: %w", err))
+	}
+
+	testEndpoint := fmt.Sprintf("/accounts/%s/access/policy-tests/%s", accountID, test.ID)
+
+	retryErr := resource.RetryContext(ctx, accessPolicyTestTimeout, func() *resource.RetryError {
+		res, err := client.Raw(http.MethodGet, testEndpoint, nil)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error reading Access policy test %q: %w", test.ID, err))
+		}
+
+		if err := json.Unmarshal(res, &test); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error unmarshalling Access policy test: %w", err))
+		}
+
+		if test.Status != "complete" {
+			if test.Status == "processing" || test.Status == "" {
+				return resource.RetryableError(fmt.Errorf("Access policy test %q is still processing (%d%% of users tested)", test.ID, test.PercentUsersTested))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Access policy test %q finished with status %q", test.ID, test.Status))
+		}
+
+		return nil
+	})
+	if retryErr != nil {
+		return diag.FromErr(retryErr)
+	}
+
+	res, err = client.Raw(http.MethodGet, fmt.Sprintf("%s/users?search=%s", testEndpoint, url.QueryEscape(userEmail)), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Access policy test results for %q: %w", userEmail, err))
+	}
+
+	var users []accessPolicyTestUser
+	if err := json.Unmarshal(res, &users); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Access policy test users: %w", err))
+	}
+
+	decision := ""
+	for _, user := range users {
+		if user.Email == userEmail {
+			decision = user.Status
+			break
+		}
+	}
+	if decision == "" {
+		return diag.FromErr(fmt.Errorf("user %q not found in the results of Access policy test %q; the user may never have authenticated through Access", userEmail, test.ID))
+	}
+
+	d.Set("decision", decision)
+	d.Set("users_approved_count", test.UsersApprovedCount)
+	d.Set("users_blocked_count", test.UsersBlockedCount)
+	d.Set("total_users_count", test.TotalUsersCount)
+	d.SetId(test.ID)
+
+	return nil
+}
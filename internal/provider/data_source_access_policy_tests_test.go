@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAccessPolicyTest(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_access_policy_test.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckDomain(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessPolicyTestConfig(rnd, accountID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "decision"),
+					resource.TestCheckResourceAttrSet(name, "total_users_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccessPolicyTestConfig(name, accountID, domain string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_access_application" "%[1]s" {
+		account_id = "%[2]s"
+		name       = "%[1]s"
+		domain     = "%[1]s.%[3]s"
+	}
+
+	resource "cloudflare_access_policy" "%[1]s" {
+		account_id     = "%[2]s"
+		application_id = cloudflare_access_application.%[1]s.id
+		name           = "%[1]s"
+		precedence     = 1
+		decision       = "allow"
+
+		include {
+			email = ["test@example.com"]
+		}
+	}
+
+	data "cloudflare_access_policy_test" "%[1]s" {
+		account_id = "%[2]s"
+		policy_id  = cloudflare_access_policy.%[1]s.id
+		user_email = "test@example.com"
+	}`, name, accountID, domain)
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccessUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareAccessUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_successful_login": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_seat": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"gateway_seat": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"seat_uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// accessUser is the API representation of a Zero Trust user, which the pinned
+// client does not yet model.
+type accessUser struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name,omitempty"`
+	Email               string `json:"email,omitempty"`
+	LastSuccessfulLogin string `json:"last_successful_login,omitempty"`
+	AccessSeat          *bool  `json:"access_seat,omitempty"`
+	GatewaySeat         *bool  `json:"gateway_seat,omitempty"`
+	SeatUID             string `json:"seat_uid,omitempty"`
+}
+
+func listAccessUsers(client *cloudflare.API, accountID string) ([]accessUser, error) {
+	perPage := 100
+
+	var users []accessUser
+	for page := 1; ; page++ {
+		endpoint := fmt.Sprintf("/accounts/%s/access/users?page=%d&per_page=%d", accountID, page, perPage)
+		res, err := client.Raw(http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error listing Access users: %w", err)
+		}
+
+		var pageUsers []accessUser
+		if err := json.Unmarshal(res, &pageUsers); err != nil {
+			return nil, fmt.Errorf("error decoding Access users: %w", err)
+		}
+
+		users = append(users, pageUsers...)
+		if len(pageUsers) < perPage {
+			break
+		}
+	}
+
+	return users, nil
+}
+
+func dataSourceCloudflareAccessUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	users, err := listAccessUsers(client, accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userIds := make([]string, 0, len(users))
+	userDetails := make([]interface{}, 0, len(users))
+	for _, user := range users {
+		detail := map[string]interface{}{
+			"id":                    user.ID,
+			"name":                  user.Name,
+			"email":                 user.Email,
+			"last_successful_login": user.LastSuccessfulLogin,
+			"seat_uid":              user.SeatUID,
+		}
+		if user.AccessSeat != nil {
+			detail["access_seat"] = *user.AccessSeat
+		}
+		if user.GatewaySeat != nil {
+			detail["gateway_seat"] = *user.GatewaySeat
+		}
+
+		userDetails = append(userDetails, detail)
+		userIds = append(userIds, user.ID)
+	}
+
+	if err := d.Set("users", userDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting users: %w", err))
+	}
+
+	d.SetId(stringListChecksum(userIds))
+	return nil
+}
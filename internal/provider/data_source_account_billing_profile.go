@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccountBillingProfile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareAccountBillingProfileRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"first_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"company": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"city": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"zipcode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"country": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"telephone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"card_number": {
+				Description: "The last four digits of the credit card on file.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"card_expiry_year": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"card_expiry_month": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"vat": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// accountBillingProfile is the API representation of an account's billing
+// profile, which the pinned client does not yet model.
+type accountBillingProfile struct {
+	ID              string `json:"id"`
+	FirstName       string `json:"first_name"`
+	LastName        string `json:"last_name"`
+	Company         string `json:"company"`
+	Address         string `json:"address"`
+	City            string `json:"city"`
+	State           string `json:"state"`
+	Zipcode         string `json:"zipcode"`
+	Country         string `json:"country"`
+	Telephone       string `json:"telephone"`
+	CardNumber      string `json:"card_number"`
+	CardExpiryYear  int    `json:"card_expiry_year"`
+	CardExpiryMonth int    `json:"card_expiry_month"`
+	VAT             string `json:"vat"`
+}
+
+func dataSourceCloudflareAccountBillingProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading billing profile for account %s", accountID))
+
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/accounts/%s/billing/profile", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading billing profile for account %q: %w", accountID, err))
+	}
+
+	var profile accountBillingProfile
+	if err := json.Unmarshal(res, &profile); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding billing profile for account %q: %w", accountID, err))
+	}
+
+	d.Set("first_name", profile.FirstName)
+	d.Set("last_name", profile.LastName)
+	d.Set("company", profile.Company)
+	d.Set("address", profile.Address)
+	d.Set("city", profile.City)
+	d.Set("state", profile.State)
+	d.Set("zipcode", profile.Zipcode)
+	d.Set("country", profile.Country)
+	d.Set("telephone", profile.Telephone)
+	d.Set("card_number", profile.CardNumber)
+	d.Set("card_expiry_year", profile.CardExpiryYear)
+	d.Set("card_expiry_month", profile.CardExpiryMonth)
+	d.Set("vat", profile.VAT)
+
+	if profile.ID != "" {
+		d.SetId(profile.ID)
+	} else {
+		d.SetId(stringChecksum(fmt.Sprintf("%s/billing-profile", accountID)))
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAccountBillingProfileDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_account_billing_profile.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccountBillingProfileDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttrSet(name, "first_name"),
+					resource.TestCheckResourceAttrSet(name, "last_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccountBillingProfileDataSourceConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	data "cloudflare_account_billing_profile" "%[1]s" {
+		account_id = "%[2]s"
+	}`, name, accountID)
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// accountCustomNS is the API representation of an account-level custom
+// nameserver, including the glue records to create at the registrar.
+type accountCustomNS struct {
+	NSName     string `json:"ns_name"`
+	NSSet      int    `json:"ns_set"`
+	Status     string `json:"status"`
+	DNSRecords []struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	} `json:"dns_records"`
+}
+
+func dataSourceCloudflareAccountCustomNS() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareAccountCustomNSRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"nameservers": {
+				Description: "The custom nameservers configured on the account.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ns_name": {
+							Description: "The fully qualified name of the nameserver.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ns_set": {
+							Description: "The nameserver set the nameserver belongs to.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The verification status of the nameserver.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"dns_records": {
+							Description: "The glue records to create at the registrar for the nameserver.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Description: "The DNS record type, `A` or `AAAA`.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"value": {
+										Description: "The IP address of the record.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"available": {
+				Description: "Nameserver names Cloudflare has assigned to the account and that are available to configure.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		Description: "Exposes the account-level custom nameservers assigned by Cloudflare, including the glue records to create at the registrar.",
+	}
+}
+
+func dataSourceCloudflareAccountCustomNSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/accounts/%s/custom_ns", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing custom nameservers for account %q: %w", accountID, err))
+	}
+
+	var customNS []accountCustomNS
+	if err := json.Unmarshal(res, &customNS); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding custom nameservers for account %q: %w", accountID, err))
+	}
+
+	res, err = client.Raw(http.MethodGet, fmt.Sprintf("/accounts/%s/custom_ns/availability", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing available custom nameserver names for account %q: %w", accountID, err))
+	}
+
+	var available []string
+	if err := json.Unmarshal(res, &available); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding available custom nameserver names for account %q: %w", accountID, err))
+	}
+
+	checksummed := make([]string, 0, len(customNS)+len(available))
+	nameservers := make([]map[string]interface{}, 0, len(customNS))
+	for _, ns := range customNS {
+		records := make([]map[string]interface{}, 0, len(ns.DNSRecords))
+		for _, record := range ns.DNSRecords {
+			records = append(records, map[string]interface{}{
+				"type":  record.Type,
+				"value": record.Value,
+			})
+		}
+		nameservers = append(nameservers, map[string]interface{}{
+			"ns_name":     ns.NSName,
+			"ns_set":      ns.NSSet,
+			"status":      ns.Status,
+			"dns_records": records,
+		})
+		checksummed = append(checksummed, fmt.Sprintf("%s/%d/%s", ns.NSName, ns.NSSet, ns.Status))
+	}
+	checksummed = append(checksummed, available...)
+
+	d.SetId(stringListChecksum(checksummed))
+	d.Set("nameservers", nameservers)
+	d.Set("available", available)
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAccountCustomNsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_account_custom_ns.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccountCustomNsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "available"),
+					resource.TestCheckResourceAttrSet(name, "nameservers.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccountCustomNsDataSourceConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	data "cloudflare_account_custom_ns" "%[1]s" {
+		account_id = "%[2]s"
+	}`, name, accountID)
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccountSubscriptions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareAccountSubscriptionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"subscriptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rate_plan_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"currency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"frequency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"current_period_end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// accountSubscription is the API representation of an account subscription,
+// which the pinned client does not yet model.
+type accountSubscription struct {
+	ID      string `json:"id"`
+	Product struct {
+		Name string `json:"name"`
+	} `json:"product"`
+	RatePlan struct {
+		ID string `json:"id"`
+	} `json:"rate_plan"`
+	State            string  `json:"state"`
+	Price            float64 `json:"price"`
+	Currency         string  `json:"currency"`
+	Frequency        string  `json:"frequency"`
+	CurrentPeriodEnd string  `json:"current_period_end"`
+}
+
+func dataSourceCloudflareAccountSubscriptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading subscriptions for account %s", accountID))
+
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/accounts/%s/subscriptions", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing subscriptions for account %q: %w", accountID, err))
+	}
+
+	var subscriptions []accountSubscription
+	if err := json.Unmarshal(res, &subscriptions); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding subscriptions for account %q: %w", accountID, err))
+	}
+
+	subscriptionIds := make([]string, 0)
+	subscriptionDetails := make([]interface{}, 0)
+
+	for _, s := range subscriptions {
+		subscriptionDetails = append(subscriptionDetails, map[string]interface{}{
+			"id":                 s.ID,
+			"product_name":       s.Product.Name,
+			"rate_plan_id":       s.RatePlan.ID,
+			"state":              s.State,
+			"price":              s.Price,
+			"currency":           s.Currency,
+			"frequency":          s.Frequency,
+			"current_period_end": s.CurrentPeriodEnd,
+		})
+		subscriptionIds = append(subscriptionIds, s.ID)
+	}
+
+	if err := d.Set("subscriptions", subscriptionDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting subscriptions: %w", err))
+	}
+
+	d.SetId(stringListChecksum(subscriptionIds))
+	return nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAccountSubscriptionsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_account_subscriptions.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccountSubscriptionsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "subscriptions.#"),
+					resource.TestCheckResourceAttrSet(name, "subscriptions.0.product_name"),
+					resource.TestCheckResourceAttrSet(name, "subscriptions.0.state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccountSubscriptionsDataSourceConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	data "cloudflare_account_subscriptions" "%[1]s" {
+		account_id = "%[2]s"
+	}`, name, accountID)
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// apiTokenPolicyTemplates maps each template name to the API token permission
+// group names it is composed of.
+var apiTokenPolicyTemplates = map[string][]string{
+	"dns-edit-only":    {"DNS Write"},
+	"cache-purge-only": {"Cache Purge"},
+}
+
+func dataSourceCloudflareApiTokenPolicyTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareApiTokenPolicyTemplateRead,
+
+		Schema: map[string]*schema.Schema{
+			"template": {
+				Description:  fmt.Sprintf("The name of the policy template. %s", renderAvailableDocumentationValuesStringSlice(apiTokenPolicyTemplateNames())),
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(apiTokenPolicyTemplateNames(), false),
+			},
+			"zone_id": {
+				Description: "The zone ID to scope the policy to. Unset scopes the policy to all zones.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"permission_groups": {
+				Description: "The IDs of the permission groups the template is composed of, for use in the `permission_groups` attribute of `cloudflare_api_token` policies.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"resources": {
+				Description: "The resource map the template applies to, for use in the `resources` attribute of `cloudflare_api_token` policies.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func apiTokenPolicyTemplateNames() []string {
+	names := make([]string, 0, len(apiTokenPolicyTemplates))
+	for name := range apiTokenPolicyTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func dataSourceCloudflareApiTokenPolicyTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	template := d.Get("template").(string)
+
+	permissions, err := client.ListAPITokensPermissionGroups(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing API Token Permission Groups: %w", err))
+	}
+
+	groupIDsByName := make(map[string]string, len(permissions))
+	for _, permission := range permissions {
+		groupIDsByName[permission.Name] = permission.ID
+	}
+
+	groupIDs := []string{}
+	for _, name := range apiTokenPolicyTemplates[template] {
+		id, ok := groupIDsByName[name]
+		if !ok {
+			return diag.FromErr(fmt.Errorf("permission group %q required by template %q not found", name, template))
+		}
+		groupIDs = append(groupIDs, id)
+	}
+
+	resources := map[string]interface{}{
+		"com.cloudflare.api.account.zone.*": "*",
+	}
+	if zoneID, ok := d.GetOk("zone_id"); ok {
+		resources = map[string]interface{}{
+			fmt.Sprintf("com.cloudflare.api.account.zone.%s", zoneID.(string)): "*",
+		}
+	}
+
+	if err := d.Set("permission_groups", groupIDs); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting permission groups: %w", err))
+	}
+	if err := d.Set("resources", resources); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting resources: %w", err))
+	}
+
+	d.SetId(stringChecksum(template + "/" + d.Get("zone_id").(string) + "/" + strings.Join(groupIDs, "")))
+
+	return nil
+}
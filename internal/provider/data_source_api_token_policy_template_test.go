@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareApiTokenPolicyTemplate(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_api_token_policy_template.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareApiTokenPolicyTemplateConfig(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "template", "dns-edit-only"),
+					resource.TestCheckResourceAttrSet(name, "permission_groups.#"),
+					resource.TestCheckResourceAttrSet(name, "resources.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareApiTokenPolicyTemplateConfig(name, zoneID string) string {
+	return fmt.Sprintf(`
+	data "cloudflare_api_token_policy_template" "%[1]s" {
+		template = "dns-edit-only"
+		zone_id  = "%[2]s"
+	}`, name, zoneID)
+}
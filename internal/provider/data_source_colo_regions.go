@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type coloRegion struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+func dataSourceCloudflareColoRegions() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"regions": {
+				Description: "Cloudflare regions available to the account, usable for validating `scope` values in region-scoped settings.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Description: "Region key, e.g. `eu`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"label": {
+							Description: "Human readable region label.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceCloudflareColoRegionsRead,
+	}
+}
+
+func dataSourceCloudflareColoRegionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/accounts/%s/addressing/regional_hostnames/regions", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing regions for account %q: %w", accountID, err))
+	}
+
+	var regions []coloRegion
+	if err := json.Unmarshal(res, &regions); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding region list: %w", err))
+	}
+
+	regionDetails := make([]interface{}, 0)
+	for _, region := range regions {
+		regionDetails = append(regionDetails, map[string]interface{}{
+			"key":   region.Key,
+			"label": region.Label,
+		})
+	}
+
+	d.SetId(accountID)
+	if err := d.Set("regions", regionDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting regions: %w", err))
+	}
+
+	return nil
+}
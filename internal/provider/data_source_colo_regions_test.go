@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareColoRegionsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_colo_regions.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareColoRegionsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "regions.#"),
+					resource.TestCheckResourceAttrSet(name, "regions.0.key"),
+					resource.TestCheckResourceAttrSet(name, "regions.0.label"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareColoRegionsDataSourceConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	data "cloudflare_colo_regions" "%[1]s" {
+		account_id = "%[2]s"
+	}`, name, accountID)
+}
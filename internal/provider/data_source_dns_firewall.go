@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareDNSFirewall() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareDNSFirewallRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"cluster_id": {
+				Description: "The ID of the DNS Firewall cluster.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description: "The name of the DNS Firewall cluster.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"upstream_ips": {
+				Description: "The upstream nameserver IPs the cluster forwards queries to.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"dns_firewall_ips": {
+				Description: "The DNS Firewall IPs assigned to the cluster, to be configured as the zone's nameservers at the registrar.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareDNSFirewallRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	res, err := client.Raw(http.MethodGet, dnsFirewallEndpoint(accountID, clusterID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading DNS Firewall cluster %q: %w", clusterID, err))
+	}
+
+	var cluster dnsFirewallCluster
+	if err := json.Unmarshal(res, &cluster); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding DNS Firewall cluster %q: %w", clusterID, err))
+	}
+
+	d.SetId(cluster.ID)
+	d.Set("name", cluster.Name)
+	d.Set("upstream_ips", cluster.UpstreamIPs)
+	d.Set("dns_firewall_ips", cluster.DNSFirewallIPs)
+
+	return nil
+}
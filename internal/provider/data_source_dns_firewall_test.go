@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareDNSFirewallDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_dns_firewall.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDNSFirewallDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "upstream_ips.#", "1"),
+					resource.TestCheckResourceAttrSet(name, "dns_firewall_ips.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareDNSFirewallDataSourceConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_dns_firewall" "%[1]s" {
+		account_id   = "%[2]s"
+		name         = "%[1]s"
+		upstream_ips = ["192.0.2.53"]
+	}
+
+	data "cloudflare_dns_firewall" "%[1]s" {
+		account_id = "%[2]s"
+		cluster_id = cloudflare_dns_firewall.%[1]s.id
+	}`, name, accountID)
+}
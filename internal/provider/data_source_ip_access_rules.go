@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareIPAccessRules() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description:  "The account identifier to target for the resource. Conflicts with `zone_id`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"account_id", "zone_id"},
+			},
+			"zone_id": {
+				Description:  "The zone identifier to target for the resource. Conflicts with `account_id`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"account_id", "zone_id"},
+			},
+			"mode": {
+				Description:  "Only return rules applying this action.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"block", "challenge", "whitelist", "js_challenge", "managed_challenge"}, false),
+			},
+			"configuration_target": {
+				Description:  "Only return rules matching on this configuration target.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ip", "ip_range", "asn", "country"}, false),
+			},
+			"rules": {
+				Description: "The matching IP access rules.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"configuration_target": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"configuration_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"notes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scope": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"modified_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceCloudflareIPAccessRulesRead,
+	}
+}
+
+func dataSourceCloudflareIPAccessRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	zoneID := d.Get("zone_id").(string)
+
+	// the mode and configuration target filters are pushed down to the API
+	// query string
+	filter := cloudflare.AccessRule{
+		Mode: d.Get("mode").(string),
+		Configuration: cloudflare.AccessRuleConfiguration{
+			Target: d.Get("configuration_target").(string),
+		},
+	}
+
+	ruleDetails := make([]interface{}, 0)
+	ruleIds := []string{accountID, zoneID, filter.Mode, filter.Configuration.Target}
+
+	for page := 1; ; page++ {
+		var (
+			res *cloudflare.AccessRuleListResponse
+			err error
+		)
+		if zoneID != "" {
+			res, err = client.ListZoneAccessRules(ctx, zoneID, filter, page)
+		} else {
+			res, err = client.ListAccountAccessRules(ctx, accountID, filter, page)
+		}
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing IP access rules: %w", err))
+		}
+
+		for _, rule := range res.Result {
+			ruleIds = append(ruleIds, rule.ID)
+			ruleDetails = append(ruleDetails, map[string]interface{}{
+				"id":                   rule.ID,
+				"mode":                 rule.Mode,
+				"configuration_target": rule.Configuration.Target,
+				"configuration_value":  rule.Configuration.Value,
+				"notes":                rule.Notes,
+				"scope":                rule.Scope.Type,
+				"created_on":           rule.CreatedOn.Format(time.RFC3339),
+				"modified_on":          rule.ModifiedOn.Format(time.RFC3339),
+			})
+		}
+
+		if page >= res.TotalPages {
+			break
+		}
+	}
+
+	d.SetId(stringListChecksum(ruleIds))
+	if err := d.Set("rules", ruleDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting rules: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareIPAccessRules(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_ip_access_rules.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareIPAccessRulesConfig(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rules.#", "1"),
+					resource.TestCheckResourceAttr(name, "rules.0.mode", "block"),
+					resource.TestCheckResourceAttr(name, "rules.0.configuration_value", "192.0.2.111"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareIPAccessRulesConfig(name, zoneID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_access_rule" "%[1]s" {
+		zone_id = "%[2]s"
+		mode    = "block"
+		notes   = "%[1]s"
+
+		configuration {
+			target = "ip"
+			value  = "192.0.2.111"
+		}
+	}
+
+	data "cloudflare_ip_access_rules" "%[1]s" {
+		zone_id = "%[2]s"
+		mode    = cloudflare_access_rule.%[1]s.mode
+	}`, name, zoneID)
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ja3FingerprintIntel is the API representation of the Bot Management intel
+// held for a JA3/JA4 TLS fingerprint.
+type ja3FingerprintIntel struct {
+	Fingerprint      string   `json:"fingerprint"`
+	Type             string   `json:"type"`
+	VerifiedBot      bool     `json:"verified_bot"`
+	BotName          string   `json:"bot_name"`
+	CommonUserAgents []string `json:"common_user_agents"`
+	LastSeen         string   `json:"last_seen"`
+}
+
+func dataSourceCloudflareJA3Fingerprint() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareJA3FingerprintRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"fingerprint": {
+				Description: "The JA3 or JA4 TLS fingerprint to look up.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"type": {
+				Description: "The kind of fingerprint, either `ja3` or `ja4`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"verified_bot": {
+				Description: "Whether the fingerprint belongs to a verified bot.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"bot_name": {
+				Description: "The name of the verified bot the fingerprint belongs to, if any.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"common_user_agents": {
+				Description: "User agents commonly observed with the fingerprint.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"last_seen": {
+				Description: "When traffic with the fingerprint was last observed, in RFC 3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+		Description: "Looks up the Bot Management intel held for a JA3/JA4 TLS fingerprint, for building fingerprint-based firewall rules.",
+	}
+}
+
+func dataSourceCloudflareJA3FingerprintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	fingerprint := d.Get("fingerprint").(string)
+
+	endpoint := fmt.Sprintf("/accounts/%s/intel/fingerprints?fingerprint=%s", accountID, url.QueryEscape(fingerprint))
+	res, err := client.Raw(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error looking up fingerprint %q: %w", fingerprint, err))
+	}
+
+	var results []ja3FingerprintIntel
+	if err := json.Unmarshal(res, &results); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding fingerprint intel: %w", err))
+	}
+
+	if len(results) == 0 {
+		return diag.FromErr(fmt.Errorf("no intel found for fingerprint %q", fingerprint))
+	}
+	intel := results[0]
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/%s", accountID, intel.Fingerprint)))
+	d.Set("type", intel.Type)
+	d.Set("verified_bot", intel.VerifiedBot)
+	d.Set("bot_name", intel.BotName)
+	d.Set("common_user_agents", intel.CommonUserAgents)
+	d.Set("last_seen", intel.LastSeen)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareJA3FingerprintDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_ja3_fingerprint.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareJA3FingerprintDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "fingerprint", "579ccef312d18482fc42e2b822ca2430"),
+					resource.TestCheckResourceAttr(name, "type", "ja3"),
+					resource.TestCheckResourceAttrSet(name, "verified_bot"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareJA3FingerprintDataSourceConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	data "cloudflare_ja3_fingerprint" "%[1]s" {
+		account_id  = "%[2]s"
+		fingerprint = "579ccef312d18482fc42e2b822ca2430"
+	}`, name, accountID)
+}
@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareLoadBalancerPools() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareLoadBalancerPoolsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"monitor": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"pools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"monitor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"minimum_origins": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"notification_email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latitude": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"longitude": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"check_regions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"created_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"modified_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"healthy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"origins": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"weight": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"healthy": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"failure_reason": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareLoadBalancerPoolsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	filter, err := expandFilterLoadBalancerPools(d.Get("filter"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Load Balancer Pools"))
+	poolList, err := client.ListLoadBalancerPools(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing load balancer pools: %w", err))
+	}
+
+	poolIds := make([]string, 0)
+	poolDetails := make([]interface{}, 0)
+	for _, pool := range poolList {
+		if filter.Name != nil && !filter.Name.Match([]byte(pool.Name)) {
+			continue
+		}
+
+		if filter.Monitor != "" && filter.Monitor != pool.Monitor {
+			continue
+		}
+
+		detail := map[string]interface{}{
+			"id":                 pool.ID,
+			"name":               pool.Name,
+			"description":        pool.Description,
+			"enabled":            pool.Enabled,
+			"monitor":            pool.Monitor,
+			"minimum_origins":    pool.MinimumOrigins,
+			"notification_email": pool.NotificationEmail,
+			"check_regions":      flattenStringList(pool.CheckRegions),
+		}
+
+		if pool.Latitude != nil {
+			detail["latitude"] = float64(*pool.Latitude)
+		}
+		if pool.Longitude != nil {
+			detail["longitude"] = float64(*pool.Longitude)
+		}
+		if pool.CreatedOn != nil {
+			detail["created_on"] = pool.CreatedOn.Format(time.RFC3339Nano)
+		}
+		if pool.ModifiedOn != nil {
+			detail["modified_on"] = pool.ModifiedOn.Format(time.RFC3339Nano)
+		}
+
+		// Health details are only reported for pools with a monitor
+		// attached. Pools without one are considered healthy.
+		originHealth := map[string]loadBalancerOriginHealthSummary{}
+		poolHealthy := true
+		if pool.Monitor != "" {
+			health, err := client.PoolHealthDetails(ctx, pool.ID)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error fetching health details for load balancer pool %s: %w", pool.ID, err))
+			}
+			poolHealthy, originHealth = summarizeLoadBalancerPoolHealth(health)
+		}
+		detail["healthy"] = poolHealthy
+
+		origins := make([]interface{}, 0, len(pool.Origins))
+		for _, origin := range pool.Origins {
+			originDetail := map[string]interface{}{
+				"name":    origin.Name,
+				"address": origin.Address,
+				"enabled": origin.Enabled,
+				"weight":  origin.Weight,
+				"healthy": true,
+			}
+			if health, ok := originHealth[origin.Name]; ok {
+				originDetail["healthy"] = health.Healthy
+				originDetail["failure_reason"] = health.FailureReason
+			}
+			origins = append(origins, originDetail)
+		}
+		detail["origins"] = origins
+
+		poolDetails = append(poolDetails, detail)
+		poolIds = append(poolIds, pool.ID)
+	}
+
+	if err := d.Set("pools", poolDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting load balancer pools: %w", err))
+	}
+
+	d.SetId(stringListChecksum(poolIds))
+	return nil
+}
+
+type loadBalancerOriginHealthSummary struct {
+	Healthy       bool
+	FailureReason string
+}
+
+// summarizeLoadBalancerPoolHealth collapses the per-PoP health report into an
+// overall pool verdict and a per-origin summary. The pool and each origin are
+// healthy only when every PoP reports them healthy; the failure reason is
+// taken from the first PoP (in name order, for determinism) reporting one.
+func summarizeLoadBalancerPoolHealth(health cloudflare.LoadBalancerPoolHealth) (bool, map[string]loadBalancerOriginHealthSummary) {
+	pops := make([]string, 0, len(health.PopHealth))
+	for pop := range health.PopHealth {
+		pops = append(pops, pop)
+	}
+	sort.Strings(pops)
+
+	poolHealthy := true
+	origins := map[string]loadBalancerOriginHealthSummary{}
+	for _, pop := range pops {
+		popHealth := health.PopHealth[pop]
+		if !popHealth.Healthy {
+			poolHealthy = false
+		}
+
+		for _, originReport := range popHealth.Origins {
+			for name, report := range originReport {
+				summary, seen := origins[name]
+				if !seen {
+					summary.Healthy = true
+				}
+				if !report.Healthy {
+					summary.Healthy = false
+				}
+				if summary.FailureReason == "" {
+					summary.FailureReason = report.FailureReason
+				}
+				origins[name] = summary
+			}
+		}
+	}
+
+	return poolHealthy, origins
+}
+
+func expandFilterLoadBalancerPools(d interface{}) (*searchFilterLoadBalancerPools, error) {
+	cfg := d.([]interface{})
+	filter := &searchFilterLoadBalancerPools{}
+	if len(cfg) == 0 || cfg[0] == nil {
+		return filter, nil
+	}
+
+	m := cfg[0].(map[string]interface{})
+	name, ok := m["name"]
+	if ok && name.(string) != "" {
+		match, err := regexp.Compile(name.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Name = match
+	}
+
+	monitor, ok := m["monitor"]
+	if ok {
+		filter.Monitor = monitor.(string)
+	}
+
+	return filter, nil
+}
+
+type searchFilterLoadBalancerPools struct {
+	Name    *regexp.Regexp
+	Monitor string
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareLoadBalancerPools(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_load_balancer_pools.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareLoadBalancerPoolsConfig(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "pools.#", "1"),
+					resource.TestCheckResourceAttr(name, "pools.0.name", rnd),
+					resource.TestCheckResourceAttr(name, "pools.0.origins.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareLoadBalancerPoolsConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_load_balancer_pool" "%[1]s" {
+		name = "%[1]s"
+
+		origins {
+			name    = "example-1"
+			address = "192.0.2.1"
+			enabled = true
+		}
+	}
+
+	data "cloudflare_load_balancer_pools" "%[1]s" {
+		filter {
+			name = cloudflare_load_balancer_pool.%[1]s.name
+		}
+	}`, name)
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareOriginCACertificate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareOriginCACertificateRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The Origin CA Certificate unique identifier.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hostnames": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"expires_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"request_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"requested_validity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"revoked_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareOriginCACertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	certID := d.Get("id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Origin CA Certificate %s", certID))
+	cert, err := client.OriginCertificate(ctx, certID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding Origin CA Certificate %q: %w", certID, err))
+	}
+
+	d.SetId(cert.ID)
+	d.Set("certificate", cert.Certificate)
+	d.Set("hostnames", expandStringListToSet(cert.Hostnames))
+	d.Set("expires_on", cert.ExpiresOn.Format(time.RFC3339))
+	d.Set("request_type", cert.RequestType)
+	d.Set("requested_validity", cert.RequestValidity)
+	if !cert.RevokedAt.IsZero() {
+		d.Set("revoked_at", cert.RevokedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareOriginCACertificateDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_origin_ca_certificate.%s", rnd)
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	csr, err := generateCSR(zoneName)
+	if err != nil {
+		t.Errorf("unable to generate CSR: %v", err)
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDomain(t)
+			testAccPreCheckApiUserServiceKey(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareOriginCACertificateDataSourceConfig(rnd, zoneName, csr),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "request_type", "origin-rsa"),
+					resource.TestCheckResourceAttrSet(name, "certificate"),
+					resource.TestCheckResourceAttrSet(name, "expires_on"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareOriginCACertificateDataSourceConfig(name, zoneName, csr string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_origin_ca_certificate" "%[1]s" {
+		csr                = <<EOT
+%[3]s
+EOT
+		hostnames          = ["%[2]s"]
+		request_type       = "origin-rsa"
+		requested_validity = 7
+	}
+
+	data "cloudflare_origin_ca_certificate" "%[1]s" {
+		id = cloudflare_origin_ca_certificate.%[1]s.id
+	}`, name, zoneName, csr)
+}
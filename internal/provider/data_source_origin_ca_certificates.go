@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareOriginCACertificates() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareOriginCACertificatesRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Description: "The zone identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"hostname": {
+				Description: "Only include certificates valid for this hostname.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"include_revoked": {
+				Description: "Whether to include revoked certificates.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"certificates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostnames": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"expires_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"requested_validity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"revoked_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareOriginCACertificatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Origin CA Certificates for zone %s", zoneID))
+	certList, err := client.OriginCertificates(ctx, cloudflare.OriginCACertificateListOptions{ZoneID: zoneID})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Origin CA Certificates for zone %q: %w", zoneID, err))
+	}
+
+	hostname := d.Get("hostname").(string)
+	includeRevoked := d.Get("include_revoked").(bool)
+
+	certIds := make([]string, 0)
+	certDetails := make([]interface{}, 0)
+	for _, cert := range certList {
+		if !includeRevoked && !cert.RevokedAt.IsZero() {
+			continue
+		}
+
+		if hostname != "" && !contains(cert.Hostnames, hostname) {
+			continue
+		}
+
+		detail := map[string]interface{}{
+			"id":                 cert.ID,
+			"certificate":        cert.Certificate,
+			"hostnames":          flattenStringList(cert.Hostnames),
+			"expires_on":         cert.ExpiresOn.Format(time.RFC3339),
+			"request_type":       cert.RequestType,
+			"requested_validity": cert.RequestValidity,
+		}
+		if !cert.RevokedAt.IsZero() {
+			detail["revoked_at"] = cert.RevokedAt.Format(time.RFC3339)
+		}
+
+		certDetails = append(certDetails, detail)
+		certIds = append(certIds, cert.ID)
+	}
+
+	if err := d.Set("certificates", certDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting Origin CA Certificates: %w", err))
+	}
+
+	d.SetId(stringListChecksum(certIds))
+	return nil
+}
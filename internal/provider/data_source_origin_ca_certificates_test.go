@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareOriginCACertificatesDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_origin_ca_certificates.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	csr, err := generateCSR(zoneName)
+	if err != nil {
+		t.Errorf("unable to generate CSR: %v", err)
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDomain(t)
+			testAccPreCheckApiUserServiceKey(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareOriginCACertificatesDataSourceConfig(rnd, zoneID, zoneName, csr),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "certificates.#"),
+					resource.TestCheckResourceAttrSet(name, "certificates.0.id"),
+					resource.TestCheckResourceAttrSet(name, "certificates.0.expires_on"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareOriginCACertificatesDataSourceConfig(name, zoneID, zoneName, csr string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_origin_ca_certificate" "%[1]s" {
+		csr                = <<EOT
+%[4]s
+EOT
+		hostnames          = ["%[3]s"]
+		request_type       = "origin-rsa"
+		requested_validity = 7
+	}
+
+	data "cloudflare_origin_ca_certificates" "%[1]s" {
+		zone_id  = "%[2]s"
+		hostname = cloudflare_origin_ca_certificate.%[1]s.hostnames[0]
+	}`, name, zoneID, zoneName, csr)
+}
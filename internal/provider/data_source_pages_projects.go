@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflarePagesProjects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflarePagesProjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"projects": {
+				Description: "The Pages projects of the account.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The identifier of the Pages project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the Pages project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"subdomain": {
+							Description: "The `pages.dev` subdomain of the Pages project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"domains": {
+							Description: "The custom domains attached to the Pages project.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"created_on": {
+							Description: "When the Pages project was created, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"latest_deployment": {
+							Description: "The latest deployment of the Pages project. Empty when the project has not been deployed yet.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Description: "The identifier of the deployment.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"environment": {
+										Description: "The environment the deployment was made to, `production` or `preview`.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"url": {
+										Description: "The URL of the deployment.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"status": {
+										Description: "The status of the latest stage of the deployment, e.g. `success` or `failure`.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"modified_on": {
+										Description: "When the deployment was last modified, in RFC 3339 format.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Description: "Lists the Pages projects of an account.",
+	}
+}
+
+func dataSourceCloudflarePagesProjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	projects := []cloudflare.PagesProject{}
+	for page := 1; ; page++ {
+		res, resultInfo, err := client.ListPagesProjects(ctx, accountID, cloudflare.PaginationOptions{Page: page})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing Pages projects of account %q: %w", accountID, err))
+		}
+
+		projects = append(projects, res...)
+
+		if page >= resultInfo.TotalPages {
+			break
+		}
+	}
+
+	checksummed := make([]string, 0, len(projects))
+	flattened := make([]map[string]interface{}, 0, len(projects))
+	for _, project := range projects {
+		createdOn := ""
+		if project.CreatedOn != nil {
+			createdOn = project.CreatedOn.Format(time.RFC3339Nano)
+		}
+
+		latestDeployment := []map[string]interface{}{}
+		if project.LatestDeployment.ID != "" {
+			modifiedOn := ""
+			if project.LatestDeployment.ModifiedOn != nil {
+				modifiedOn = project.LatestDeployment.ModifiedOn.Format(time.RFC3339Nano)
+			}
+			latestDeployment = append(latestDeployment, map[string]interface{}{
+				"id":          project.LatestDeployment.ID,
+				"environment": project.LatestDeployment.Environment,
+				"url":         project.LatestDeployment.URL,
+				"status":      project.LatestDeployment.LatestStage.Status,
+				"modified_on": modifiedOn,
+			})
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"id":                project.ID,
+			"name":              project.Name,
+			"subdomain":         project.SubDomain,
+			"domains":           project.Domains,
+			"created_on":        createdOn,
+			"latest_deployment": latestDeployment,
+		})
+		checksummed = append(checksummed, fmt.Sprintf("%s/%s", project.ID, project.LatestDeployment.ID))
+	}
+
+	d.SetId(stringListChecksum(checksummed))
+	d.Set("projects", flattened)
+
+	return nil
+}
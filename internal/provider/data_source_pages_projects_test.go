@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflarePagesProjectsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_pages_projects.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflarePagesProjectsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "projects.#"),
+					resource.TestCheckResourceAttrSet(name, "projects.0.name"),
+					resource.TestCheckResourceAttrSet(name, "projects.0.subdomain"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflarePagesProjectsDataSourceConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_pages_project" "%[1]s" {
+		account_id        = "%[2]s"
+		name              = "%[1]s"
+		production_branch = "main"
+	}
+
+	data "cloudflare_pages_projects" "%[1]s" {
+		account_id = "%[2]s"
+
+		depends_on = [cloudflare_pages_project.%[1]s]
+	}`, name, accountID)
+}
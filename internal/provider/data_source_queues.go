@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// queueListItem is one entry of the queue list response, which carries
+// producer and consumer counts the single-queue endpoint does not.
+type queueListItem struct {
+	ID                  string `json:"queue_id"`
+	Name                string `json:"queue_name"`
+	CreatedOn           string `json:"created_on"`
+	ModifiedOn          string `json:"modified_on"`
+	ProducersTotalCount int    `json:"producers_total_count"`
+	ConsumersTotalCount int    `json:"consumers_total_count"`
+}
+
+func dataSourceCloudflareQueues() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareQueuesRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The account identifier to target for the resource.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the queue with this name.",
+			},
+			"queues": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The queue identifier.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the queue.",
+						},
+						"producers_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of producers bound to the queue.",
+						},
+						"consumers_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of consumers of the queue.",
+						},
+						"created_on": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp of when the queue was created.",
+						},
+						"modified_on": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp of when the queue was last modified.",
+						},
+					},
+				},
+				Description: "A list of queues in the account.",
+			},
+		},
+		Description: "Use this data source to list the [Queues](https://developers.cloudflare.com/queues/) of an account, for inventory and for validating queue bindings.",
+	}
+}
+
+func dataSourceCloudflareQueuesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/accounts/%s/queues", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing queues: %w", err))
+	}
+
+	var items []queueListItem
+	if err := json.Unmarshal(res, &items); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling queues: %w", err))
+	}
+
+	queues := []interface{}{}
+	checksummed := []string{accountID, name}
+	for _, item := range items {
+		if name != "" && item.Name != name {
+			continue
+		}
+		queues = append(queues, map[string]interface{}{
+			"id":              item.ID,
+			"name":            item.Name,
+			"producers_count": item.ProducersTotalCount,
+			"consumers_count": item.ConsumersTotalCount,
+			"created_on":      item.CreatedOn,
+			"modified_on":     item.ModifiedOn,
+		})
+		checksummed = append(checksummed, item.ID)
+	}
+
+	if err := d.Set("queues", queues); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting queues: %w", err))
+	}
+	d.SetId(stringListChecksum(checksummed))
+
+	return nil
+}
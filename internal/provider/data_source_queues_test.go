@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareQueues(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_queues.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareQueuesConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "queues.#", "1"),
+					resource.TestCheckResourceAttr(name, "queues.0.name", rnd),
+					resource.TestCheckResourceAttrSet(name, "queues.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareQueuesConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_queue" "%[1]s" {
+		account_id = "%[2]s"
+		name       = "%[1]s"
+	}
+
+	data "cloudflare_queues" "%[1]s" {
+		account_id = "%[2]s"
+		name       = cloudflare_queue.%[1]s.name
+	}`, name, accountID)
+}
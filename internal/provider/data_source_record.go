@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareRecord() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareRecordRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Description: "The zone identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"hostname": {
+				Description: "Hostname to filter DNS record results on.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"type": {
+				Description: "DNS record type to filter record results on.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "A",
+			},
+			"priority": {
+				Description: "DNS priority to filter record results on.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"value": {
+				Description: "Value of the found DNS record.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"proxied": {
+				Description: "Proxied status of the found DNS record.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"proxiable": {
+				Description: "Whether the found DNS record can be proxied.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"ttl": {
+				Description: "TTL of the found DNS record.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"locked": {
+				Description: "Locked status of the found DNS record.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"zone_name": {
+				Description: "Name of the zone the found DNS record belongs to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	hostname := d.Get("hostname").(string)
+	recordType := d.Get("type").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading DNS record %s %s in zone %s", recordType, hostname, zoneID))
+
+	records, err := client.DNSRecords(ctx, zoneID, cloudflare.DNSRecord{
+		Name: hostname,
+		Type: recordType,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing DNS records: %w", err))
+	}
+
+	// MX records can legitimately share a hostname; the priority narrows the
+	// lookup down to a single record
+	if priority, ok := d.GetOkExists("priority"); ok {
+		filtered := records[:0]
+		for _, r := range records {
+			if r.Priority != nil && int(*r.Priority) == priority.(int) {
+				filtered = append(filtered, r)
+			}
+		}
+		records = filtered
+	}
+
+	if len(records) == 0 {
+		return diag.FromErr(fmt.Errorf("no DNS record found for %s %q in zone %s", recordType, hostname, zoneID))
+	}
+
+	if len(records) > 1 {
+		return diag.FromErr(fmt.Errorf("more than one DNS record found for %s %q in zone %s; set `priority` to target the record more specifically", recordType, hostname, zoneID))
+	}
+
+	record := records[0]
+
+	d.SetId(record.ID)
+	d.Set("hostname", record.Name)
+	d.Set("type", record.Type)
+	d.Set("value", record.Content)
+	d.Set("proxied", record.Proxied != nil && *record.Proxied)
+	d.Set("proxiable", record.Proxiable)
+	d.Set("ttl", record.TTL)
+	d.Set("locked", record.Locked)
+	d.Set("zone_name", record.ZoneName)
+	if record.Priority != nil {
+		d.Set("priority", int(*record.Priority))
+	}
+
+	return nil
+}
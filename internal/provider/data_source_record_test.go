@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareRecordDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_record.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDomain(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRecordDataSourceConfig(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "hostname", fmt.Sprintf("%s.%s", rnd, domain)),
+					resource.TestCheckResourceAttr(name, "type", "A"),
+					resource.TestCheckResourceAttr(name, "value", "192.0.2.1"),
+					resource.TestCheckResourceAttr(name, "proxied", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRecordDataSourceConfig(name, zoneID, domain string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_record" "%[1]s" {
+		zone_id = "%[2]s"
+		name    = "%[1]s"
+		type    = "A"
+		value   = "192.0.2.1"
+	}
+
+	data "cloudflare_record" "%[1]s" {
+		zone_id  = "%[2]s"
+		hostname = cloudflare_record.%[1]s.hostname
+	}`, name, zoneID, domain)
+}
@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Description: "The zone identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description: "The type of the records to look up.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"name": {
+							Description: "The exact name of the records to look up.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"name_contains": {
+							Description: "A substring the record names must contain.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"name_starts_with": {
+							Description: "A prefix the record names must start with.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"content": {
+							Description: "The exact content of the records to look up.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"proxied": {
+							Description:  "Whether the records are proxied through Cloudflare. Available values: `true`, `false`. Unset matches both.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+						},
+						"tag": {
+							Description: "A tag the records must carry, either as `name` or `name:value`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"content": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"proxied": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"created_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"modified_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dnsRecordListEntry is the API representation of a listed DNS record,
+// including the tags field the pinned client does not yet model.
+type dnsRecordListEntry struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`
+	Content    string   `json:"content"`
+	TTL        int      `json:"ttl"`
+	Priority   *uint16  `json:"priority,omitempty"`
+	Proxied    *bool    `json:"proxied,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+	CreatedOn  string   `json:"created_on,omitempty"`
+	ModifiedOn string   `json:"modified_on,omitempty"`
+}
+
+// dnsRecordsFilterValues translates the filter block into the query string
+// parameters of the list DNS records endpoint, so filtering happens
+// server-side instead of downloading every record of the zone.
+func dnsRecordsFilterValues(d *schema.ResourceData) url.Values {
+	v := url.Values{}
+
+	if filter, ok := d.GetOk("filter"); ok && len(filter.([]interface{})) == 1 {
+		m := filter.([]interface{})[0].(map[string]interface{})
+
+		for attribute, param := range map[string]string{
+			"type":             "type",
+			"name":             "name",
+			"name_contains":    "name.contains",
+			"name_starts_with": "name.startswith",
+			"content":          "content",
+			"proxied":          "proxied",
+			"tag":              "tag",
+		} {
+			if value := m[attribute].(string); value != "" {
+				v.Set(param, value)
+			}
+		}
+	}
+
+	return v
+}
+
+func dataSourceCloudflareRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	query := dnsRecordsFilterValues(d)
+
+	perPage := 100
+	query.Set("per_page", strconv.Itoa(perPage))
+
+	var records []dnsRecordListEntry
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+
+		res, err := client.Raw(http.MethodGet, fmt.Sprintf("/zones/%s/dns_records?%s", zoneID, query.Encode()), nil)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing DNS records: %w", err))
+		}
+
+		var pageRecords []dnsRecordListEntry
+		if err := json.Unmarshal(res, &pageRecords); err != nil {
+			return diag.FromErr(fmt.Errorf("error decoding DNS records: %w", err))
+		}
+
+		records = append(records, pageRecords...)
+		if len(pageRecords) < perPage {
+			break
+		}
+	}
+
+	recordIds := make([]string, 0, len(records))
+	recordDetails := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		detail := map[string]interface{}{
+			"id":          record.ID,
+			"name":        record.Name,
+			"type":        record.Type,
+			"content":     record.Content,
+			"ttl":         record.TTL,
+			"tags":        record.Tags,
+			"created_on":  record.CreatedOn,
+			"modified_on": record.ModifiedOn,
+		}
+		if record.Priority != nil {
+			detail["priority"] = int(*record.Priority)
+		}
+		if record.Proxied != nil {
+			detail["proxied"] = *record.Proxied
+		}
+
+		recordDetails = append(recordDetails, detail)
+		recordIds = append(recordIds, record.ID)
+	}
+
+	if err := d.Set("records", recordDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting records: %w", err))
+	}
+
+	d.SetId(stringListChecksum(recordIds))
+	return nil
+}
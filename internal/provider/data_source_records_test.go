@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareRecordsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_records.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDomain(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRecordsDataSourceConfig(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.#", "1"),
+					resource.TestCheckResourceAttr(name, "records.0.name", fmt.Sprintf("%s.%s", rnd, domain)),
+					resource.TestCheckResourceAttr(name, "records.0.type", "A"),
+					resource.TestCheckResourceAttr(name, "records.0.content", "192.0.2.1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRecordsDataSourceConfig(name, zoneID, domain string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_record" "%[1]s" {
+		zone_id = "%[2]s"
+		name    = "%[1]s"
+		type    = "A"
+		value   = "192.0.2.1"
+	}
+
+	data "cloudflare_records" "%[1]s" {
+		zone_id = "%[2]s"
+
+		filter {
+			name = cloudflare_record.%[1]s.hostname
+			type = "A"
+		}
+	}`, name, zoneID, domain)
+}
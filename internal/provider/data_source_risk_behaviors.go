@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareRiskBehaviors() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"behaviors": {
+				Description: "Zero Trust risk behaviors available on the account.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"risk_level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceCloudflareRiskBehaviorsRead,
+	}
+}
+
+func dataSourceCloudflareRiskBehaviorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	behaviors, err := riskBehaviorsFromAPI(client, accountID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing risk behaviors for account %q: %w", accountID, err))
+	}
+
+	behaviorDetails := make([]interface{}, 0)
+	for name, behavior := range behaviors.Behaviors {
+		behaviorDetails = append(behaviorDetails, map[string]interface{}{
+			"name":        name,
+			"description": behavior.Description,
+			"enabled":     behavior.Enabled,
+			"risk_level":  behavior.RiskLevel,
+		})
+	}
+
+	d.SetId(accountID)
+	if err := d.Set("behaviors", behaviorDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting risk behaviors: %w", err))
+	}
+
+	return nil
+}
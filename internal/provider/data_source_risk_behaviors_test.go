@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareRiskBehaviorsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_risk_behaviors.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRiskBehaviorsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "behaviors.#"),
+					resource.TestCheckResourceAttrSet(name, "behaviors.0.name"),
+					resource.TestCheckResourceAttrSet(name, "behaviors.0.risk_level"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRiskBehaviorsDataSourceConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	data "cloudflare_risk_behaviors" "%[1]s" {
+		account_id = "%[2]s"
+	}`, name, accountID)
+}
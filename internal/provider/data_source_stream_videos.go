@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareStreamVideos() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareStreamVideosRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"creator": {
+				Description: "Only list videos with the given creator ID.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"search": {
+				Description: "Only list videos whose name contains the given string.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"after": {
+				Description:  "Only list videos created after the given RFC3339 timestamp.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"before": {
+				Description:  "Only list videos created before the given RFC3339 timestamp.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"status": {
+				Description: "Only list videos in the given processing state.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"videos": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ready_to_stream": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"thumbnail": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"preview": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"playback_hls": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"playback_dash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uploaded": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareStreamVideosRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	params := cloudflare.StreamListParameters{
+		AccountID: accountID,
+		Creator:   d.Get("creator").(string),
+		Search:    d.Get("search").(string),
+		Status:    d.Get("status").(string),
+	}
+
+	if after, ok := d.GetOk("after"); ok {
+		t, _ := time.Parse(time.RFC3339, after.(string))
+		params.After = &t
+	}
+	if before, ok := d.GetOk("before"); ok {
+		t, _ := time.Parse(time.RFC3339, before.(string))
+		params.Before = &t
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing Stream videos for account %s", accountID))
+
+	videos, err := client.StreamListVideos(ctx, params)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Stream videos for account %q: %w", accountID, err))
+	}
+
+	videoIds := make([]string, 0)
+	videoDetails := make([]interface{}, 0)
+
+	for _, v := range videos {
+		name, _ := v.Meta["name"].(string)
+		detail := map[string]interface{}{
+			"uid":             v.UID,
+			"name":            name,
+			"creator":         v.Creator,
+			"duration":        v.Duration,
+			"size":            v.Size,
+			"status":          v.Status.State,
+			"ready_to_stream": v.ReadyToStream,
+			"thumbnail":       v.Thumbnail,
+			"preview":         v.Preview,
+			"playback_hls":    v.Playback.HLS,
+			"playback_dash":   v.Playback.Dash,
+		}
+		if v.Created != nil {
+			detail["created"] = v.Created.Format(time.RFC3339)
+		}
+		if v.Uploaded != nil {
+			detail["uploaded"] = v.Uploaded.Format(time.RFC3339)
+		}
+		videoDetails = append(videoDetails, detail)
+		videoIds = append(videoIds, v.UID)
+	}
+
+	if err := d.Set("videos", videoDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting videos: %w", err))
+	}
+
+	d.SetId(stringListChecksum(videoIds))
+	return nil
+}
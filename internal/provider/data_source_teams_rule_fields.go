@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// teamsRuleExpressionRuletypes lists the Gateway rule expression attributes
+// in the order the data source reports them.
+var teamsRuleExpressionRuletypes = []string{"traffic", "identity", "device_posture"}
+
+// teamsRuleExpressionFields maps each Gateway rule expression attribute to
+// the wirefilter fields that are valid in it.
+var teamsRuleExpressionFields = map[string][]string{
+	"traffic": {
+		"app.hosts.ids",
+		"app.ids",
+		"app.type.ids",
+		"dns.content_category",
+		"dns.domains",
+		"dns.dst.ip",
+		"dns.fqdn",
+		"dns.query_rtype",
+		"dns.resolved_ips",
+		"dns.security_category",
+		"dns.src.ip",
+		"dns.src.port",
+		"http.download.file_types",
+		"http.request.domains",
+		"http.request.headers",
+		"http.request.host",
+		"http.request.method",
+		"http.request.uri",
+		"http.request.uri.content_category",
+		"http.request.uri.path",
+		"http.request.uri.query",
+		"http.request.uri.security_category",
+		"http.response.content_type",
+		"http.upload.file_types",
+		"net.detected_protocol",
+		"net.dst.ip",
+		"net.dst.port",
+		"net.protocol",
+		"net.sni.domains",
+		"net.sni.host",
+		"net.src.ip",
+		"net.src.port",
+		"net.vnet_id",
+	},
+	"identity": {
+		"identity.email",
+		"identity.groups.email",
+		"identity.groups.id",
+		"identity.groups.name",
+		"identity.saml_attributes",
+		"identity.service_token_uuid",
+		"identity.user_id",
+	},
+	"device_posture": {
+		"device_posture.checks.failed",
+		"device_posture.checks.passed",
+	},
+}
+
+func dataSourceCloudflareTeamsRuleFields() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareTeamsRuleFieldsRead,
+		Schema: map[string]*schema.Schema{
+			"ruletype": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(teamsRuleExpressionRuletypes, false),
+				Description:  "Only list the fields valid in this rule expression attribute. Valid values: `traffic`, `identity`, `device_posture`.",
+			},
+			"fields": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The wirefilter field name.",
+						},
+						"ruletype": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The rule expression attribute the field is valid in.",
+						},
+					},
+				},
+				Description: "The fields valid in Gateway rule expressions.",
+			},
+		},
+		Description: "Use this data source to look up the wirefilter fields that are valid in the `traffic`, `identity` and `device_posture` expressions of a `cloudflare_teams_rule`.",
+	}
+}
+
+func dataSourceCloudflareTeamsRuleFieldsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filter := d.Get("ruletype").(string)
+
+	fields := []interface{}{}
+	checksummed := []string{filter}
+	for _, ruletype := range teamsRuleExpressionRuletypes {
+		if filter != "" && filter != ruletype {
+			continue
+		}
+		for _, name := range teamsRuleExpressionFields[ruletype] {
+			fields = append(fields, map[string]interface{}{
+				"name":     name,
+				"ruletype": ruletype,
+			})
+			checksummed = append(checksummed, name)
+		}
+	}
+
+	if err := d.Set("fields", fields); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(stringListChecksum(checksummed))
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareTeamsRuleFields(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_teams_rule_fields.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsRuleFieldsConfig(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "fields.#"),
+					resource.TestCheckResourceAttr(name, "fields.0.ruletype", "traffic"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareTeamsRuleFieldsConfig(name string) string {
+	return fmt.Sprintf(`
+	data "cloudflare_teams_rule_fields" "%[1]s" {
+		ruletype = "traffic"
+	}`, name)
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// tunnelCloudflaredConfigResult is the raw configuration of a tunnel as the
+// API returns it: the config itself is a stringified JSON document.
+type tunnelCloudflaredConfigResult struct {
+	TunnelID string `json:"tunnel_id"`
+	Config   string `json:"config"`
+	Version  int    `json:"version"`
+}
+
+// tunnelCloudflaredConfigFile is the rendered config.yml handed to a locally
+// run cloudflared, with the remotely-managed configuration inlined.
+type tunnelCloudflaredConfigFile struct {
+	Tunnel          string                 `yaml:"tunnel"`
+	CredentialsFile string                 `yaml:"credentials-file"`
+	Config          map[string]interface{} `yaml:",inline"`
+}
+
+func dataSourceCloudflareTunnelCloudflaredConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareTunnelCloudflaredConfigRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The account identifier to target for the resource.",
+			},
+			"tunnel_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the tunnel to render the configuration of.",
+			},
+			"credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path written into the `credentials-file` stanza. Defaults to `/etc/cloudflared/<tunnel_id>.json`.",
+			},
+			"yaml": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The tunnel configuration rendered as a cloudflared `config.yml`.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the tunnel configuration.",
+			},
+		},
+		Description: "Use this data source to render the effective configuration of a tunnel as a cloudflared `config.yml`, e.g. to template it into VM user-data or a Kubernetes ConfigMap.",
+	}
+}
+
+func dataSourceCloudflareTunnelCloudflaredConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	tunnelID := d.Get("tunnel_id").(string)
+
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/accounts/%s/cfd_tunnel/%s/configurations", accountID, tunnelID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading configuration of tunnel %q: %w", tunnelID, err))
+	}
+
+	var result tunnelCloudflaredConfigResult
+	if err := json.Unmarshal(res, &result); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling configuration of tunnel %q: %w", tunnelID, err))
+	}
+
+	// the config is converted from its JSON representation without going
+	// through typed structs, so settings unknown to this provider version
+	// still end up in the rendered file
+	config := map[string]interface{}{}
+	if result.Config != "" {
+		if err := json.Unmarshal([]byte(result.Config), &config); err != nil {
+			return diag.FromErr(fmt.Errorf("error unmarshalling configuration of tunnel %q: %w", tunnelID, err))
+		}
+	}
+
+	credentialsFile := d.Get("credentials_file").(string)
+	if credentialsFile == "" {
+		credentialsFile = fmt.Sprintf("/etc/cloudflared/%s.json", tunnelID)
+	}
+
+	rendered, err := yaml.Marshal(tunnelCloudflaredConfigFile{
+		Tunnel:          tunnelID,
+		CredentialsFile: credentialsFile,
+		Config:          config,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error rendering configuration of tunnel %q: %w", tunnelID, err))
+	}
+
+	d.Set("yaml", string(rendered))
+	d.Set("version", result.Version)
+	d.SetId(stringChecksum(string(rendered)))
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareTunnelCloudflaredConfigDataSource(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Tunnel
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_tunnel_cloudflared_config.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTunnelCloudflaredConfigDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "yaml"),
+					resource.TestCheckResourceAttrSet(name, "credentials_file"),
+					resource.TestCheckResourceAttrSet(name, "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareTunnelCloudflaredConfigDataSourceConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_tunnel" "%[1]s" {
+		account_id = "%[2]s"
+		name       = "%[1]s"
+		secret     = "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="
+	}
+
+	resource "cloudflare_tunnel_cloudflared_config" "%[1]s" {
+		account_id = "%[2]s"
+		tunnel_id  = cloudflare_tunnel.%[1]s.id
+
+		config {
+			ingress_rule {
+				service = "http_status:404"
+			}
+		}
+	}
+
+	data "cloudflare_tunnel_cloudflared_config" "%[1]s" {
+		account_id = "%[2]s"
+		tunnel_id  = cloudflare_tunnel_cloudflared_config.%[1]s.tunnel_id
+	}`, name, accountID)
+}
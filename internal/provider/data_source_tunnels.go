@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type tunnelListEntry struct {
+	ID          string                        `json:"id"`
+	Name        string                        `json:"name"`
+	Status      string                        `json:"status"`
+	CreatedAt   *time.Time                    `json:"created_at"`
+	DeletedAt   *time.Time                    `json:"deleted_at"`
+	Connections []cloudflare.TunnelConnection `json:"connections"`
+}
+
+func dataSourceCloudflareTunnels() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name_prefix": {
+				Description: "Only return tunnels whose name starts with this prefix.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"status": {
+				Description:  "Only return tunnels with this status.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"healthy", "degraded", "down", "inactive"}, false),
+			},
+			"has_connections": {
+				Description: "When set, only return tunnels that do (or don't) have open connections.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"tunnels": {
+				Description: "The matching tunnels.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connection_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		ReadContext: dataSourceCloudflareTunnelsRead,
+	}
+}
+
+func dataSourceCloudflareTunnelsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	// The tunnel filters are pushed down to the API query string so large
+	// accounts don't need every tunnel to be fetched and filtered locally.
+	query := url.Values{}
+	query.Set("is_deleted", "false")
+	if namePrefix, ok := d.GetOk("name_prefix"); ok {
+		query.Set("include_prefix", namePrefix.(string))
+	}
+	if status, ok := d.GetOk("status"); ok {
+		query.Set("status", status.(string))
+	}
+
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/accounts/%s/cfd_tunnel?%s", accountID, query.Encode()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing tunnels for account %q: %w", accountID, err))
+	}
+
+	var tunnels []tunnelListEntry
+	if err := json.Unmarshal(res, &tunnels); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding tunnel list: %w", err))
+	}
+
+	tunnelDetails := make([]interface{}, 0)
+	for _, tunnel := range tunnels {
+		if hasConnections, ok := d.GetOkExists("has_connections"); ok {
+			if hasConnections.(bool) != (len(tunnel.Connections) > 0) {
+				continue
+			}
+		}
+
+		createdAt := ""
+		if tunnel.CreatedAt != nil {
+			createdAt = tunnel.CreatedAt.Format(time.RFC3339)
+		}
+
+		tunnelDetails = append(tunnelDetails, map[string]interface{}{
+			"id":               tunnel.ID,
+			"name":             tunnel.Name,
+			"status":           tunnel.Status,
+			"created_at":       createdAt,
+			"connection_count": len(tunnel.Connections),
+		})
+	}
+
+	d.SetId(stringListChecksum([]string{accountID, d.Get("name_prefix").(string), d.Get("status").(string)}))
+	if err := d.Set("tunnels", tunnelDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tunnels: %w", err))
+	}
+
+	return nil
+}
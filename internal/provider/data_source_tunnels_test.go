@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareTunnels_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_tunnels.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTunnelsConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "tunnels.#", "1"),
+					resource.TestCheckResourceAttr(name, "tunnels.0.name", rnd),
+					resource.TestCheckResourceAttrSet(name, "tunnels.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareTunnelsConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_tunnel" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  secret     = "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="
+}
+
+data "cloudflare_tunnels" "%[1]s" {
+  account_id  = "%[2]s"
+  name_prefix = cloudflare_tunnel.%[1]s.name
+}`, rnd, accountID)
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareWorkerRoutes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareWorkerRoutesRead,
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The zone identifier to target for the resource.",
+			},
+			"script_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list routes pointing at the Worker script with this name.",
+			},
+			"routes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The route identifier.",
+						},
+						"pattern": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The route pattern.",
+						},
+						"script": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the Worker script the route invokes.",
+						},
+					},
+				},
+				Description: "A list of Worker routes in the zone.",
+			},
+		},
+		Description: "Use this data source to list the Worker routes of a zone, for audit tooling and cross-workspace references.",
+	}
+}
+
+func dataSourceCloudflareWorkerRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	scriptName := d.Get("script_name").(string)
+
+	resp, err := client.ListWorkerRoutes(ctx, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing worker routes for zone %q: %w", zoneID, err))
+	}
+
+	routes := []interface{}{}
+	checksummed := []string{zoneID, scriptName}
+	for _, route := range resp.Routes {
+		if scriptName != "" && route.Script != scriptName {
+			continue
+		}
+		routes = append(routes, map[string]interface{}{
+			"id":      route.ID,
+			"pattern": route.Pattern,
+			"script":  route.Script,
+		})
+		checksummed = append(checksummed, route.ID)
+	}
+
+	if err := d.Set("routes", routes); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting routes: %w", err))
+	}
+	d.SetId(stringListChecksum(checksummed))
+
+	return nil
+}
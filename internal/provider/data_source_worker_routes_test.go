@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareWorkerRoutesDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_worker_routes.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDomain(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkerRoutesDataSourceConfig(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "routes.#", "1"),
+					resource.TestCheckResourceAttr(name, "routes.0.pattern", fmt.Sprintf("%s.%s/*", rnd, domain)),
+					resource.TestCheckResourceAttr(name, "routes.0.script", rnd),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkerRoutesDataSourceConfig(name, zoneID, domain string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_worker_script" "%[1]s" {
+		name    = "%[1]s"
+		content = "addEventListener('fetch', event => { event.respondWith(fetch(event.request)) })"
+	}
+
+	resource "cloudflare_worker_route" "%[1]s" {
+		zone_id     = "%[2]s"
+		pattern     = "%[1]s.%[3]s/*"
+		script_name = cloudflare_worker_script.%[1]s.name
+	}
+
+	data "cloudflare_worker_routes" "%[1]s" {
+		zone_id     = "%[2]s"
+		script_name = cloudflare_worker_route.%[1]s.script_name
+	}`, name, zoneID, domain)
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// workerScriptListItem is one entry of the script list response.
+type workerScriptListItem struct {
+	ID         string `json:"id"`
+	ETag       string `json:"etag"`
+	Size       int    `json:"size"`
+	CreatedOn  string `json:"created_on"`
+	ModifiedOn string `json:"modified_on"`
+}
+
+// workerScriptBindingSummary is the part of a binding the inventory exposes;
+// binding-type specific fields such as namespace IDs are left out.
+type workerScriptBindingSummary struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+func dataSourceCloudflareWorkerScripts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareWorkerScriptsRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The account identifier to target for the resource.",
+			},
+			"scripts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the script.",
+						},
+						"etag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The hash of the script content.",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the script, in bytes.",
+						},
+						"created_on": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp of when the script was created.",
+						},
+						"modified_on": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp of when the script was last modified.",
+						},
+						"bindings": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the binding.",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type of the binding, e.g. `kv_namespace` or `r2_bucket`.",
+									},
+								},
+							},
+							Description: "A summary of the bindings of the script.",
+						},
+					},
+				},
+				Description: "A list of Worker scripts in the account.",
+			},
+		},
+		Description: "Use this data source to list the Worker scripts of an account, with a summary of their bindings and their modification times, for audit tooling and cross-workspace references.",
+	}
+}
+
+func dataSourceCloudflareWorkerScriptsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/accounts/%s/workers/scripts", accountID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing worker scripts: %w", err))
+	}
+
+	var items []workerScriptListItem
+	if err := json.Unmarshal(res, &items); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling worker scripts: %w", err))
+	}
+
+	scripts := []interface{}{}
+	checksummed := []string{accountID}
+	for _, item := range items {
+		res, err := client.Raw(http.MethodGet, fmt.Sprintf("/accounts/%s/workers/scripts/%s/bindings", accountID, item.ID), nil)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing bindings of worker script %q: %w", item.ID, err))
+		}
+
+		var bindingSummaries []workerScriptBindingSummary
+		if err := json.Unmarshal(res, &bindingSummaries); err != nil {
+			return diag.FromErr(fmt.Errorf("error unmarshalling bindings of worker script %q: %w", item.ID, err))
+		}
+
+		bindings := []interface{}{}
+		for _, binding := range bindingSummaries {
+			bindings = append(bindings, map[string]interface{}{
+				"name": binding.Name,
+				"type": binding.Type,
+			})
+		}
+
+		scripts = append(scripts, map[string]interface{}{
+			"name":        item.ID,
+			"etag":        item.ETag,
+			"size":        item.Size,
+			"created_on":  item.CreatedOn,
+			"modified_on": item.ModifiedOn,
+			"bindings":    bindings,
+		})
+		checksummed = append(checksummed, item.ID, item.ETag)
+	}
+
+	if err := d.Set("scripts", scripts); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting scripts: %w", err))
+	}
+	d.SetId(stringListChecksum(checksummed))
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareWorkerScriptsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_worker_scripts.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkerScriptsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "scripts.#"),
+					resource.TestCheckResourceAttrSet(name, "scripts.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkerScriptsDataSourceConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_worker_script" "%[1]s" {
+		name    = "%[1]s"
+		content = "addEventListener('fetch', event => { event.respondWith(fetch(event.request)) })"
+	}
+
+	data "cloudflare_worker_scripts" "%[1]s" {
+		account_id = "%[2]s"
+
+		depends_on = [cloudflare_worker_script.%[1]s]
+	}`, name, accountID)
+}
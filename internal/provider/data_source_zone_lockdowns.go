@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareZoneLockdowns() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareZoneLockdownsRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Description: "The zone identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"lockdowns": {
+				Description: "The Zone Lockdown rules of the zone, including their priority, so ordering of overlapping rules can be audited.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The identifier of the Zone Lockdown rule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the Zone Lockdown rule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"urls": {
+							Description: "The URL patterns the Zone Lockdown rule applies to.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"configurations": {
+							Description: "The IP addresses or CIDR ranges permitted by the Zone Lockdown rule.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target": {
+										Description: "The configuration target, `ip` or `ip_range`.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"value": {
+										Description: "The IP address or CIDR range.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"paused": {
+							Description: "Whether the Zone Lockdown rule is paused.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"priority": {
+							Description: "The priority of the Zone Lockdown rule relative to overlapping rules.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"modified_on": {
+							Description: "When the Zone Lockdown rule was last modified, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Description: "Lists the Zone Lockdown rules of a zone.",
+	}
+}
+
+func dataSourceCloudflareZoneLockdownsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	lockdowns := []cloudflare.ZoneLockdown{}
+	for page := 1; ; page++ {
+		res, err := client.ListZoneLockdowns(ctx, zoneID, page)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing Zone Lockdown rules of zone %q: %w", zoneID, err))
+		}
+
+		lockdowns = append(lockdowns, res.Result...)
+
+		if page >= res.ResultInfo.TotalPages {
+			break
+		}
+	}
+
+	checksummed := make([]string, 0, len(lockdowns))
+	flattened := make([]map[string]interface{}, 0, len(lockdowns))
+	for _, lockdown := range lockdowns {
+		configurations := make([]map[string]interface{}, 0, len(lockdown.Configurations))
+		for _, configuration := range lockdown.Configurations {
+			configurations = append(configurations, map[string]interface{}{
+				"target": configuration.Target,
+				"value":  configuration.Value,
+			})
+		}
+
+		modifiedOn := ""
+		if lockdown.ModifiedOn != nil {
+			modifiedOn = lockdown.ModifiedOn.Format(time.RFC3339Nano)
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"id":             lockdown.ID,
+			"description":    lockdown.Description,
+			"urls":           lockdown.URLs,
+			"configurations": configurations,
+			"paused":         lockdown.Paused,
+			"priority":       lockdown.Priority,
+			"modified_on":    modifiedOn,
+		})
+		checksummed = append(checksummed, fmt.Sprintf("%s/%d/%s", lockdown.ID, lockdown.Priority, modifiedOn))
+	}
+
+	d.SetId(stringListChecksum(checksummed))
+	d.Set("lockdowns", flattened)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareZoneLockdownsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_zone_lockdowns.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDomain(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneLockdownsDataSourceConfig(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "lockdowns.#"),
+					resource.TestCheckResourceAttrSet(name, "lockdowns.0.id"),
+					resource.TestCheckResourceAttrSet(name, "lockdowns.0.urls.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneLockdownsDataSourceConfig(name, zoneID, domain string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_zone_lockdown" "%[1]s" {
+		zone_id     = "%[2]s"
+		description = "%[1]s"
+		urls        = ["%[1]s.%[3]s/*"]
+
+		configurations {
+			target = "ip"
+			value  = "198.51.100.4"
+		}
+	}
+
+	data "cloudflare_zone_lockdowns" "%[1]s" {
+		zone_id = "%[2]s"
+
+		depends_on = [cloudflare_zone_lockdown.%[1]s]
+	}`, name, zoneID, domain)
+}
@@ -143,19 +143,52 @@ func New(version string) func() *schema.Provider {
 					DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_API_BASE_PATH", "/client/v4"),
 					Description: "Configure the base path used by the API client. Alternatively, can be configured using the `CLOUDFLARE_API_BASE_PATH` environment variable.",
 				},
+
+				"serialize_zone_writes": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_SERIALIZE_ZONE_WRITES", false),
+					Description: "Whether to serialize write operations per zone. Avoids `another operation is in progress` API errors when many page rules, rulesets, filters or firewall rules in the same zone are applied concurrently, while keeping cross-zone parallelism. Alternatively, can be configured using the `CLOUDFLARE_SERIALIZE_ZONE_WRITES` environment variable.",
+				},
 			},
 
 			DataSourcesMap: map[string]*schema.Resource{
+				"cloudflare_access_app_types":            dataSourceCloudflareAccessAppTypes(),
 				"cloudflare_access_identity_provider":    dataSourceCloudflareAccessIdentityProvider(),
+				"cloudflare_access_policy_test":          dataSourceCloudflareAccessPolicyTest(),
+				"cloudflare_access_users":                dataSourceCloudflareAccessUsers(),
+				"cloudflare_account_billing_profile":     dataSourceCloudflareAccountBillingProfile(),
+				"cloudflare_account_custom_ns":           dataSourceCloudflareAccountCustomNS(),
 				"cloudflare_account_roles":               dataSourceCloudflareAccountRoles(),
+				"cloudflare_account_subscriptions":       dataSourceCloudflareAccountSubscriptions(),
 				"cloudflare_api_token_permission_groups": dataSourceCloudflareApiTokenPermissionGroups(),
+				"cloudflare_api_token_policy_template":   dataSourceCloudflareApiTokenPolicyTemplate(),
+				"cloudflare_colo_regions":                dataSourceCloudflareColoRegions(),
 				"cloudflare_devices":                     dataSourceCloudflareDevices(),
+				"cloudflare_dns_firewall":                dataSourceCloudflareDNSFirewall(),
+				"cloudflare_ip_access_rules":             dataSourceCloudflareIPAccessRules(),
+				"cloudflare_ja3_fingerprint":             dataSourceCloudflareJA3Fingerprint(),
 				"cloudflare_ip_ranges":                   dataSourceCloudflareIPRanges(),
+				"cloudflare_load_balancer_pools":         dataSourceCloudflareLoadBalancerPools(),
+				"cloudflare_origin_ca_certificate":       dataSourceCloudflareOriginCACertificate(),
+				"cloudflare_origin_ca_certificates":      dataSourceCloudflareOriginCACertificates(),
 				"cloudflare_origin_ca_root_certificate":  dataSourceCloudflareOriginCARootCertificate(),
+				"cloudflare_pages_projects":              dataSourceCloudflarePagesProjects(),
+				"cloudflare_queues":                      dataSourceCloudflareQueues(),
+				"cloudflare_record":                      dataSourceCloudflareRecord(),
+				"cloudflare_records":                     dataSourceCloudflareRecords(),
+				"cloudflare_risk_behaviors":              dataSourceCloudflareRiskBehaviors(),
+				"cloudflare_stream_videos":               dataSourceCloudflareStreamVideos(),
+				"cloudflare_teams_rule_fields":           dataSourceCloudflareTeamsRuleFields(),
+				"cloudflare_tunnel_cloudflared_config":   dataSourceCloudflareTunnelCloudflaredConfig(),
+				"cloudflare_tunnels":                     dataSourceCloudflareTunnels(),
 				"cloudflare_waf_groups":                  dataSourceCloudflareWAFGroups(),
 				"cloudflare_waf_packages":                dataSourceCloudflareWAFPackages(),
 				"cloudflare_waf_rules":                   dataSourceCloudflareWAFRules(),
+				"cloudflare_worker_routes":               dataSourceCloudflareWorkerRoutes(),
+				"cloudflare_worker_scripts":              dataSourceCloudflareWorkerScripts(),
 				"cloudflare_zone_dnssec":                 dataSourceCloudflareZoneDNSSEC(),
+				"cloudflare_zone_lockdowns":              dataSourceCloudflareZoneLockdowns(),
 				"cloudflare_zone":                        dataSourceCloudflareZone(),
 				"cloudflare_zones":                       dataSourceCloudflareZones(),
 			},
@@ -169,6 +202,7 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_access_mutual_tls_certificate":          resourceCloudflareAccessMutualTLSCertificate(),
 				"cloudflare_access_policy":                          resourceCloudflareAccessPolicy(),
 				"cloudflare_access_rule":                            resourceCloudflareAccessRule(),
+				"cloudflare_access_seats_cleanup":                   resourceCloudflareAccessSeatsCleanup(),
 				"cloudflare_access_service_token":                   resourceCloudflareAccessServiceToken(),
 				"cloudflare_access_bookmark":                        resourceCloudflareAccessBookmark(),
 				"cloudflare_account_member":                         resourceCloudflareAccountMember(),
@@ -177,63 +211,111 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_argo":                                   resourceCloudflareArgo(),
 				"cloudflare_authenticated_origin_pulls_certificate": resourceCloudflareAuthenticatedOriginPullsCertificate(),
 				"cloudflare_authenticated_origin_pulls":             resourceCloudflareAuthenticatedOriginPulls(),
+				"cloudflare_byo_ip_loa_document":                    resourceCloudflareBYOIPLOADocument(),
 				"cloudflare_byo_ip_prefix":                          resourceCloudflareBYOIPPrefix(),
 				"cloudflare_certificate_pack":                       resourceCloudflareCertificatePack(),
 				"cloudflare_custom_hostname_fallback_origin":        resourceCloudflareCustomHostnameFallbackOrigin(),
 				"cloudflare_custom_hostname":                        resourceCloudflareCustomHostname(),
 				"cloudflare_custom_pages":                           resourceCloudflareCustomPages(),
 				"cloudflare_custom_ssl":                             resourceCloudflareCustomSsl(),
+				"cloudflare_d1_database":                            resourceCloudflareD1Database(),
 				"cloudflare_device_posture_rule":                    resourceCloudflareDevicePostureRule(),
 				"cloudflare_device_policy_certificates":             resourceCloudflareDevicePolicyCertificates(),
 				"cloudflare_device_posture_integration":             resourceCloudflareDevicePostureIntegration(),
+				"cloudflare_dns_firewall":                           resourceCloudflareDNSFirewall(),
+				"cloudflare_dns_settings":                           resourceCloudflareDNSSettings(),
+				"cloudflare_dns_zone_exclusive_records":             resourceCloudflareDNSZoneExclusiveRecords(),
+				"cloudflare_email_routing_settings":                 resourceCloudflareEmailRoutingSettings(),
 				"cloudflare_fallback_domain":                        resourceCloudflareFallbackDomain(),
 				"cloudflare_filter":                                 resourceCloudflareFilter(),
 				"cloudflare_firewall_rule":                          resourceCloudflareFirewallRule(),
 				"cloudflare_gre_tunnel":                             resourceCloudflareGRETunnel(),
 				"cloudflare_healthcheck":                            resourceCloudflareHealthcheck(),
+				"cloudflare_intel_indicator_feed":                   resourceCloudflareIntelIndicatorFeed(),
 				"cloudflare_ip_list":                                resourceCloudflareIPList(),
 				"cloudflare_ipsec_tunnel":                           resourceCloudflareIPsecTunnel(),
+				"cloudflare_keyless_ssl":                            resourceCloudflareKeylessSSL(),
 				"cloudflare_list":                                   resourceCloudflareList(),
 				"cloudflare_load_balancer_monitor":                  resourceCloudflareLoadBalancerMonitor(),
 				"cloudflare_load_balancer_pool":                     resourceCloudflareLoadBalancerPool(),
 				"cloudflare_load_balancer":                          resourceCloudflareLoadBalancer(),
 				"cloudflare_logpull_retention":                      resourceCloudflareLogpullRetention(),
+				"cloudflare_logpush_edge":                           resourceCloudflareLogpushEdge(),
 				"cloudflare_logpush_job":                            resourceCloudflareLogpushJob(),
 				"cloudflare_logpush_ownership_challenge":            resourceCloudflareLogpushOwnershipChallenge(),
 				"cloudflare_magic_firewall_ruleset":                 resourceCloudflareMagicFirewallRuleset(),
+				"cloudflare_magic_wan_lan":                          resourceCloudflareMagicWANLAN(),
+				"cloudflare_magic_wan_site":                         resourceCloudflareMagicWANSite(),
+				"cloudflare_magic_wan_wan":                          resourceCloudflareMagicWANWAN(),
 				"cloudflare_managed_headers":                        resourceCloudflareManagedHeaders(),
+				"cloudflare_mtls_certificate":                       resourceCloudflareMTLSCertificate(),
 				"cloudflare_notification_policy_webhooks":           resourceCloudflareNotificationPolicyWebhooks(),
 				"cloudflare_notification_policy":                    resourceCloudflareNotificationPolicy(),
 				"cloudflare_origin_ca_certificate":                  resourceCloudflareOriginCACertificate(),
 				"cloudflare_page_rule":                              resourceCloudflarePageRule(),
+				"cloudflare_pages_domain":                           resourceCloudflarePagesDomain(),
+				"cloudflare_pages_project":                          resourceCloudflarePagesProject(),
+				"cloudflare_queue":                                  resourceCloudflareQueue(),
+				"cloudflare_queue_consumer":                         resourceCloudflareQueueConsumer(),
+				"cloudflare_r2_bucket":                              resourceCloudflareR2Bucket(),
+				"cloudflare_r2_bucket_cors":                         resourceCloudflareR2BucketCORS(),
+				"cloudflare_r2_bucket_event_notification":           resourceCloudflareR2BucketEventNotification(),
+				"cloudflare_r2_bucket_lifecycle":                    resourceCloudflareR2BucketLifecycle(),
 				"cloudflare_rate_limit":                             resourceCloudflareRateLimit(),
 				"cloudflare_record":                                 resourceCloudflareRecord(),
+				"cloudflare_registrar_contacts":                     resourceCloudflareRegistrarContacts(),
+				"cloudflare_risk_behavior":                          resourceCloudflareRiskBehavior(),
 				"cloudflare_ruleset":                                resourceCloudflareRuleset(),
+				"cloudflare_secondary_dns_acl":                      resourceCloudflareSecondaryDNSACL(),
+				"cloudflare_secondary_dns_outgoing":                 resourceCloudflareSecondaryDNSOutgoing(),
+				"cloudflare_secondary_dns_peer":                     resourceCloudflareSecondaryDNSPeer(),
+				"cloudflare_secondary_dns_tsig":                     resourceCloudflareSecondaryDNSTSIG(),
+				"cloudflare_secondary_dns_zone":                     resourceCloudflareSecondaryDNSZone(),
+				"cloudflare_security_center_insights_mute":          resourceCloudflareSecurityCenterInsightsMute(),
 				"cloudflare_spectrum_application":                   resourceCloudflareSpectrumApplication(),
+				"cloudflare_speed_settings":                         resourceCloudflareSpeedSettings(),
 				"cloudflare_split_tunnel":                           resourceCloudflareSplitTunnel(),
 				"cloudflare_static_route":                           resourceCloudflareStaticRoute(),
+				"cloudflare_stream_key":                             resourceCloudflareStreamKey(),
+				"cloudflare_stream_live_input":                      resourceCloudflareStreamLiveInput(),
+				"cloudflare_stream_webhook":                         resourceCloudflareStreamWebhook(),
 				"cloudflare_teams_account":                          resourceCloudflareTeamsAccount(),
+				"cloudflare_teams_certificate":                      resourceCloudflareTeamsCertificate(),
 				"cloudflare_teams_list":                             resourceCloudflareTeamsList(),
 				"cloudflare_teams_location":                         resourceCloudflareTeamsLocation(),
 				"cloudflare_teams_rule":                             resourceCloudflareTeamsRule(),
 				"cloudflare_teams_proxy_endpoint":                   resourceCloudflareTeamsProxyEndpoint(),
+				"cloudflare_tunnel":                                 resourceCloudflareTunnel(),
+				"cloudflare_tunnel_cloudflared_config":              resourceCloudflareTunnelCloudflaredConfig(),
 				"cloudflare_tunnel_route":                           resourceCloudflareTunnelRoute(),
 				"cloudflare_tunnel_virtual_network":                 resourceCloudflareTunnelVirtualNetwork(),
+				"cloudflare_vectorize_metadata_index":               resourceCloudflareVectorizeMetadataIndex(),
 				"cloudflare_waf_group":                              resourceCloudflareWAFGroup(),
 				"cloudflare_waf_override":                           resourceCloudflareWAFOverride(),
 				"cloudflare_waf_package":                            resourceCloudflareWAFPackage(),
+				"cloudflare_waf_payload_logging_keypair":            resourceCloudflareWAFPayloadLoggingKeypair(),
 				"cloudflare_waf_rule":                               resourceCloudflareWAFRule(),
 				"cloudflare_waiting_room":                           resourceCloudflareWaitingRoom(),
 				"cloudflare_waiting_room_event":                     resourceCloudflareWaitingRoomEvent(),
 				"cloudflare_worker_cron_trigger":                    resourceCloudflareWorkerCronTrigger(),
+				"cloudflare_worker_deployment":                      resourceCloudflareWorkerDeployment(),
+				"cloudflare_worker_domain":                          resourceCloudflareWorkerDomain(),
 				"cloudflare_worker_route":                           resourceCloudflareWorkerRoute(),
 				"cloudflare_worker_script":                          resourceCloudflareWorkerScript(),
+				"cloudflare_worker_version":                         resourceCloudflareWorkerVersion(),
+				"cloudflare_workers_for_platforms_namespace":        resourceCloudflareWorkersForPlatformsNamespace(),
 				"cloudflare_workers_kv_namespace":                   resourceCloudflareWorkersKVNamespace(),
 				"cloudflare_workers_kv":                             resourceCloudflareWorkerKV(),
+				"cloudflare_workers_kv_bulk":                        resourceCloudflareWorkersKVBulk(),
+				"cloudflare_workers_script_tail_consumer":           resourceCloudflareWorkersScriptTailConsumer(),
+				"cloudflare_workers_subdomain":                      resourceCloudflareWorkersSubdomain(),
 				"cloudflare_zone_cache_variants":                    resourceCloudflareZoneCacheVariants(),
+				"cloudflare_zone_custom_bot_category":               resourceCloudflareZoneCustomBotCategory(),
 				"cloudflare_zone_dnssec":                            resourceCloudflareZoneDNSSEC(),
 				"cloudflare_zone_lockdown":                          resourceCloudflareZoneLockdown(),
+				"cloudflare_zone_records_import":                    resourceCloudflareZoneRecordsImport(),
 				"cloudflare_zone_settings_override":                 resourceCloudflareZoneSettingsOverride(),
+				"cloudflare_zone_snippets_rules":                    resourceCloudflareZoneSnippetsRules(),
 				"cloudflare_zone":                                   resourceCloudflareZone(),
 			},
 		}
@@ -299,6 +381,7 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
+		setSerializeZoneWrites(client, d.Get("serialize_zone_writes").(bool))
 
 		if accountID, ok := d.GetOk("account_id"); ok {
 			tflog.Info(ctx, fmt.Sprintf("using specified account id %s in Cloudflare provider", accountID.(string)))
@@ -313,6 +396,7 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
+		setSerializeZoneWrites(client, d.Get("serialize_zone_writes").(bool))
 
 		return client, nil
 	}
@@ -166,8 +166,12 @@ func testAccPreCheckBYOIPPrefix(t *testing.T) {
 	}
 }
 
+// generateRandomResourceName produces a name for a resource created during an
+// acceptance test. All test fixtures should derive resource names, hostnames
+// and similar identifiers from it; it honours ResourceNamePrefix so that
+// concurrent CI runs against a shared account do not collide.
 func generateRandomResourceName() string {
-	return acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	return ResourceNamePrefix() + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 }
 
 // skipMagicTransitTestForNonConfiguredDefaultZone will force an acceptance test
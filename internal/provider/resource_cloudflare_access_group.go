@@ -72,7 +72,10 @@ func resourceCloudflareAccessGroupCreate(ctx context.Context, d *schema.Resource
 		Name: d.Get("name").(string),
 	}
 
-	newAccessGroup = appendConditionalAccessGroupFields(newAccessGroup, d)
+	newAccessGroup, err := appendConditionalAccessGroupFields(newAccessGroup, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Access Group from struct: %+v", newAccessGroup))
 
@@ -81,6 +84,12 @@ func resourceCloudflareAccessGroupCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
+	if d.Get("validate_login_methods").(bool) {
+		if err := validateAccessGroupLoginMethods(ctx, client, identifier, newAccessGroup.Include, newAccessGroup.Require, newAccessGroup.Exclude); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	var accessGroup cloudflare.AccessGroup
 	if identifier.Type == AccountType {
 		accessGroup, err = client.CreateAccessGroup(ctx, identifier.Value, newAccessGroup)
@@ -102,7 +111,10 @@ func resourceCloudflareAccessGroupUpdate(ctx context.Context, d *schema.Resource
 		ID:   d.Id(),
 	}
 
-	updatedAccessGroup = appendConditionalAccessGroupFields(updatedAccessGroup, d)
+	updatedAccessGroup, err := appendConditionalAccessGroupFields(updatedAccessGroup, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Access Group from struct: %+v", updatedAccessGroup))
 
@@ -111,6 +123,12 @@ func resourceCloudflareAccessGroupUpdate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
+	if d.Get("validate_login_methods").(bool) {
+		if err := validateAccessGroupLoginMethods(ctx, client, identifier, updatedAccessGroup.Include, updatedAccessGroup.Require, updatedAccessGroup.Exclude); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	var accessGroup cloudflare.AccessGroup
 	if identifier.Type == AccountType {
 		accessGroup, err = client.UpdateAccessGroup(ctx, identifier.Value, updatedAccessGroup)
@@ -175,29 +193,94 @@ func resourceCloudflareAccessGroupImport(ctx context.Context, d *schema.Resource
 // conditional group enforcement fields it should append to the
 // AccessGroup by iterating over the provided values and generating the
 // correct structs.
-func appendConditionalAccessGroupFields(group cloudflare.AccessGroup, d *schema.ResourceData) cloudflare.AccessGroup {
+func appendConditionalAccessGroupFields(group cloudflare.AccessGroup, d *schema.ResourceData) (cloudflare.AccessGroup, error) {
 	exclude := d.Get("exclude").([]interface{})
 	for _, value := range exclude {
 		if value != nil {
-			group.Exclude = BuildAccessGroupCondition(value.(map[string]interface{}))
+			condition, err := BuildAccessGroupCondition(value.(map[string]interface{}))
+			if err != nil {
+				return group, fmt.Errorf("failed to build exclude condition: %w", err)
+			}
+			group.Exclude = condition
 		}
 	}
 
 	require := d.Get("require").([]interface{})
 	for _, value := range require {
 		if value != nil {
-			group.Require = BuildAccessGroupCondition(value.(map[string]interface{}))
+			condition, err := BuildAccessGroupCondition(value.(map[string]interface{}))
+			if err != nil {
+				return group, fmt.Errorf("failed to build require condition: %w", err)
+			}
+			group.Require = condition
 		}
 	}
 
 	include := d.Get("include").([]interface{})
 	for _, value := range include {
 		if value != nil {
-			group.Include = BuildAccessGroupCondition(value.(map[string]interface{}))
+			condition, err := BuildAccessGroupCondition(value.(map[string]interface{}))
+			if err != nil {
+				return group, fmt.Errorf("failed to build include condition: %w", err)
+			}
+			group.Include = condition
 		}
 	}
 
-	return group
+	return group, nil
+}
+
+// conditionBlocks safely converts a condition attribute into its list of
+// blocks, rejecting null entries in the configuration instead of letting a
+// type assertion crash the provider.
+func conditionBlocks(values interface{}, accessGroupType string) ([]map[string]interface{}, error) {
+	list, ok := values.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected value for %q blocks: %v", accessGroupType, values)
+	}
+
+	blocks := make([]map[string]interface{}, 0, len(list))
+	for _, value := range list {
+		block, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("found a null or malformed %q block; remove or complete the block", accessGroupType)
+		}
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
+// conditionStringValues safely converts a list condition attribute into its
+// string elements, rejecting null entries in the configuration.
+func conditionStringValues(values interface{}, accessGroupType string) ([]string, error) {
+	list, ok := values.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected value for %q list: %v", accessGroupType, values)
+	}
+
+	items := make([]string, 0, len(list))
+	for _, value := range list {
+		item, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("found a null element in the %q list; remove it or replace it with a value", accessGroupType)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// conditionIdentityProviderID pulls the `identity_provider_id` out of an
+// identity provider backed condition block, returning a friendly error when it
+// is missing instead of letting a later type assertion panic.
+func conditionIdentityProviderID(cfg map[string]interface{}, blockType string) (string, error) {
+	id, ok := cfg["identity_provider_id"].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("%q blocks require an `identity_provider_id` referencing the associated identity provider", blockType)
+	}
+
+	return id, nil
 }
 
 // BuildAccessGroupCondition iterates the provided `map` of values and
@@ -205,7 +288,7 @@ func appendConditionalAccessGroupFields(group cloudflare.AccessGroup, d *schema.
 //
 // Returns the intended combination structure of Access Groups to build the
 // desired policy.
-func BuildAccessGroupCondition(options map[string]interface{}) []interface{} {
+func BuildAccessGroupCondition(options map[string]interface{}) ([]interface{}, error) {
 	var group []interface{}
 	for accessGroupType, values := range options {
 		if accessGroupType == "everyone" {
@@ -221,43 +304,66 @@ func BuildAccessGroupCondition(options map[string]interface{}) []interface{} {
 				group = append(group, cloudflare.AccessGroupCertificate{})
 			}
 		} else if accessGroupType == "common_name" {
-			if values != "" {
+			if commonName, ok := values.(string); ok && commonName != "" {
 				group = append(group, cloudflare.AccessGroupCertificateCommonName{CommonName: struct {
 					CommonName string `json:"common_name"`
-				}{CommonName: values.(string)}})
+				}{CommonName: commonName}})
 			}
 		} else if accessGroupType == "auth_method" {
-			if values != "" {
+			if authMethod, ok := values.(string); ok && authMethod != "" {
 				group = append(group, cloudflare.AccessGroupAuthMethod{AuthMethod: struct {
 					AuthMethod string `json:"auth_method"`
-				}{AuthMethod: values.(string)}})
+				}{AuthMethod: authMethod}})
 			}
 		} else if accessGroupType == "gsuite" {
-			for _, v := range values.([]interface{}) {
-				gsuiteCfg := v.(map[string]interface{})
-				for _, email := range gsuiteCfg["email"].([]interface{}) {
+			blocks, err := conditionBlocks(values, "gsuite")
+			if err != nil {
+				return nil, err
+			}
+			for _, gsuiteCfg := range blocks {
+				providerID, err := conditionIdentityProviderID(gsuiteCfg, "gsuite")
+				if err != nil {
+					return nil, err
+				}
+				emails, err := conditionStringValues(gsuiteCfg["email"], "gsuite email")
+				if err != nil {
+					return nil, err
+				}
+				for _, email := range emails {
 					group = append(group, cloudflare.AccessGroupGSuite{Gsuite: struct {
 						Email              string `json:"email"`
 						IdentityProviderID string `json:"identity_provider_id"`
 					}{
-						Email:              email.(string),
-						IdentityProviderID: gsuiteCfg["identity_provider_id"].(string),
+						Email:              email,
+						IdentityProviderID: providerID,
 					}})
 				}
 			}
 		} else if accessGroupType == "github" {
-			for _, v := range values.([]interface{}) {
-				githubCfg := v.(map[string]interface{})
-				if len(githubCfg["teams"].([]interface{})) > 0 {
-					for _, team := range githubCfg["teams"].([]interface{}) {
+			blocks, err := conditionBlocks(values, "github")
+			if err != nil {
+				return nil, err
+			}
+			for _, githubCfg := range blocks {
+				providerID, err := conditionIdentityProviderID(githubCfg, "github")
+				if err != nil {
+					return nil, err
+				}
+				teams, err := conditionStringValues(githubCfg["teams"], "github teams")
+				if err != nil {
+					return nil, err
+				}
+				name, _ := githubCfg["name"].(string)
+				if len(teams) > 0 {
+					for _, team := range teams {
 						group = append(group, cloudflare.AccessGroupGitHub{GitHubOrganization: struct {
 							Name               string `json:"name"`
 							Team               string `json:"team,omitempty"`
 							IdentityProviderID string `json:"identity_provider_id"`
 						}{
-							Name:               githubCfg["name"].(string),
-							Team:               team.(string),
-							IdentityProviderID: githubCfg["identity_provider_id"].(string),
+							Name:               name,
+							Team:               team,
+							IdentityProviderID: providerID,
 						}})
 					}
 				} else {
@@ -266,102 +372,181 @@ func BuildAccessGroupCondition(options map[string]interface{}) []interface{} {
 						Team               string `json:"team,omitempty"`
 						IdentityProviderID string `json:"identity_provider_id"`
 					}{
-						Name:               githubCfg["name"].(string),
-						IdentityProviderID: githubCfg["identity_provider_id"].(string),
+						Name:               name,
+						IdentityProviderID: providerID,
 					}})
 				}
 			}
 		} else if accessGroupType == "azure" {
-			for _, v := range values.([]interface{}) {
-				azureCfg := v.(map[string]interface{})
-				for _, id := range azureCfg["id"].([]interface{}) {
+			blocks, err := conditionBlocks(values, "azure")
+			if err != nil {
+				return nil, err
+			}
+			for _, azureCfg := range blocks {
+				providerID, err := conditionIdentityProviderID(azureCfg, "azure")
+				if err != nil {
+					return nil, err
+				}
+				ids, err := conditionStringValues(azureCfg["id"], "azure id")
+				if err != nil {
+					return nil, err
+				}
+				for _, id := range ids {
 					group = append(group, cloudflare.AccessGroupAzure{AzureAD: struct {
 						ID                 string `json:"id"`
 						IdentityProviderID string `json:"identity_provider_id"`
 					}{
-						ID:                 id.(string),
-						IdentityProviderID: azureCfg["identity_provider_id"].(string),
+						ID:                 id,
+						IdentityProviderID: providerID,
 					}})
 				}
 			}
 		} else if accessGroupType == "okta" {
-			for _, v := range values.([]interface{}) {
-				oktaCfg := v.(map[string]interface{})
-				for _, name := range oktaCfg["name"].([]interface{}) {
+			blocks, err := conditionBlocks(values, "okta")
+			if err != nil {
+				return nil, err
+			}
+			for _, oktaCfg := range blocks {
+				providerID, err := conditionIdentityProviderID(oktaCfg, "okta")
+				if err != nil {
+					return nil, err
+				}
+				names, err := conditionStringValues(oktaCfg["name"], "okta name")
+				if err != nil {
+					return nil, err
+				}
+				for _, name := range names {
 					group = append(group, cloudflare.AccessGroupOkta{Okta: struct {
 						Name               string `json:"name"`
 						IdentityProviderID string `json:"identity_provider_id"`
 					}{
-						Name:               name.(string),
-						IdentityProviderID: oktaCfg["identity_provider_id"].(string),
+						Name:               name,
+						IdentityProviderID: providerID,
 					}})
 				}
 			}
 		} else if accessGroupType == "saml" {
-			for _, v := range values.([]interface{}) {
-				samlCfg := v.(map[string]interface{})
+			blocks, err := conditionBlocks(values, "saml")
+			if err != nil {
+				return nil, err
+			}
+			for _, samlCfg := range blocks {
+				providerID, _ := samlCfg["identity_provider_id"].(string)
+				attributeName, _ := samlCfg["attribute_name"].(string)
+				attributeValue, _ := samlCfg["attribute_value"].(string)
 				group = append(group, cloudflare.AccessGroupSAML{Saml: struct {
 					AttributeName      string `json:"attribute_name"`
 					AttributeValue     string `json:"attribute_value"`
 					IdentityProviderID string `json:"identity_provider_id"`
 				}{
-					AttributeName:      samlCfg["attribute_name"].(string),
-					AttributeValue:     samlCfg["attribute_value"].(string),
-					IdentityProviderID: samlCfg["identity_provider_id"].(string),
+					AttributeName:      attributeName,
+					AttributeValue:     attributeValue,
+					IdentityProviderID: providerID,
 				}})
 			}
 		} else if accessGroupType == "external_evaluation" {
-			for _, v := range values.([]interface{}) {
-				eeCfg := v.(map[string]interface{})
+			blocks, err := conditionBlocks(values, "external_evaluation")
+			if err != nil {
+				return nil, err
+			}
+			for _, eeCfg := range blocks {
+				evaluateURL, _ := eeCfg["evaluate_url"].(string)
+				keysURL, _ := eeCfg["keys_url"].(string)
 				group = append(group, cloudflare.AccessGroupExternalEvaluation{ExternalEvaluation: struct {
 					EvaluateURL string `json:"evaluate_url"`
 					KeysURL     string `json:"keys_url"`
 				}{
-					EvaluateURL: eeCfg["evaluate_url"].(string),
-					KeysURL:     eeCfg["keys_url"].(string),
+					EvaluateURL: evaluateURL,
+					KeysURL:     keysURL,
 				}})
 			}
 		} else {
-			for _, value := range values.([]interface{}) {
+			items, err := conditionStringValues(values, accessGroupType)
+			if err != nil {
+				return nil, err
+			}
+			for _, value := range items {
 				switch accessGroupType {
 				case "email":
 					group = append(group, cloudflare.AccessGroupEmail{Email: struct {
 						Email string `json:"email"`
-					}{Email: value.(string)}})
+					}{Email: value}})
 				case "email_domain":
 					group = append(group, cloudflare.AccessGroupEmailDomain{EmailDomain: struct {
 						Domain string `json:"domain"`
-					}{Domain: value.(string)}})
+					}{Domain: value}})
 				case "ip":
 					group = append(group, cloudflare.AccessGroupIP{IP: struct {
 						IP string `json:"ip"`
-					}{IP: value.(string)}})
+					}{IP: value}})
 				case "service_token":
 					group = append(group, cloudflare.AccessGroupServiceToken{ServiceToken: struct {
 						ID string `json:"token_id"`
-					}{ID: value.(string)}})
+					}{ID: value}})
 				case "group":
 					group = append(group, cloudflare.AccessGroupAccessGroup{Group: struct {
 						ID string `json:"id"`
-					}{ID: value.(string)}})
+					}{ID: value}})
 				case "geo":
 					group = append(group, cloudflare.AccessGroupGeo{Geo: struct {
 						CountryCode string `json:"country_code"`
-					}{CountryCode: value.(string)}})
+					}{CountryCode: value}})
 				case "login_method":
 					group = append(group, cloudflare.AccessGroupLoginMethod{LoginMethod: struct {
 						ID string `json:"id"`
-					}{ID: value.(string)}})
+					}{ID: value}})
 				case "device_posture":
 					group = append(group, cloudflare.AccessGroupDevicePosture{DevicePosture: struct {
 						ID string `json:"integration_uid"`
-					}{ID: value.(string)}})
+					}{ID: value}})
 				}
 			}
 		}
 	}
 
-	return group
+	return group, nil
+}
+
+// validateAccessGroupLoginMethods checks any `login_method` condition values
+// against the identity providers configured on the account or zone, surfacing
+// unknown IDs before they are sent to the API.
+func validateAccessGroupLoginMethods(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, conditions ...[]interface{}) error {
+	loginMethodIDs := []string{}
+	for _, condition := range conditions {
+		for _, value := range condition {
+			if loginMethod, ok := value.(cloudflare.AccessGroupLoginMethod); ok {
+				loginMethodIDs = append(loginMethodIDs, loginMethod.LoginMethod.ID)
+			}
+		}
+	}
+
+	if len(loginMethodIDs) == 0 {
+		return nil
+	}
+
+	var providers []cloudflare.AccessIdentityProvider
+	var err error
+	if identifier.Type == AccountType {
+		providers, err = client.AccessIdentityProviders(ctx, identifier.Value)
+	} else {
+		providers, err = client.ZoneLevelAccessIdentityProviders(ctx, identifier.Value)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to list identity providers to validate login_method values: %w", err)
+	}
+
+	knownProviders := map[string]bool{}
+	for _, provider := range providers {
+		knownProviders[provider.ID] = true
+	}
+
+	for _, id := range loginMethodIDs {
+		if !knownProviders[id] {
+			return fmt.Errorf("login_method value %q does not match any identity provider configured on the %s", id, identifier.Type)
+		}
+	}
+
+	return nil
 }
 
 // TransformAccessGroupForSchema takes the incoming `accessGroup` from the API
@@ -377,8 +562,8 @@ func TransformAccessGroupForSchema(ctx context.Context, accessGroup []interface{
 	authMethod := ""
 	geos := []string{}
 	loginMethod := []string{}
-	oktaID := ""
-	oktaGroups := []string{}
+	oktaIDs := []string{}
+	oktaGroupsByIDP := map[string][]string{}
 	gsuiteID := ""
 	gsuiteEmails := []string{}
 	githubName := ""
@@ -433,8 +618,20 @@ func TransformAccessGroupForSchema(ctx context.Context, accessGroup []interface{
 				}
 			case "okta":
 				oktaCfg := groupValue.(map[string]interface{})
-				oktaID = oktaCfg["identity_provider_id"].(string)
-				oktaGroups = append(oktaGroups, oktaCfg["name"].(string))
+				oktaID, _ := oktaCfg["identity_provider_id"].(string)
+				if !contains(oktaIDs, oktaID) {
+					oktaIDs = append(oktaIDs, oktaID)
+				}
+				// the API returns one condition per group name, but older
+				// responses carry the names as an array on a single condition
+				switch name := oktaCfg["name"].(type) {
+				case string:
+					oktaGroupsByIDP[oktaID] = append(oktaGroupsByIDP[oktaID], name)
+				case []interface{}:
+					for _, groupName := range name {
+						oktaGroupsByIDP[oktaID] = append(oktaGroupsByIDP[oktaID], groupName.(string))
+					}
+				}
 			case "gsuite":
 				gsuiteCfg := groupValue.(map[string]interface{})
 				gsuiteID = gsuiteCfg["identity_provider_id"].(string)
@@ -520,13 +717,16 @@ func TransformAccessGroupForSchema(ctx context.Context, accessGroup []interface{
 		})
 	}
 
-	if len(oktaGroups) > 0 && oktaID != "" {
+	if len(oktaIDs) > 0 {
+		oktaBlocks := []interface{}{}
+		for _, oktaID := range oktaIDs {
+			oktaBlocks = append(oktaBlocks, map[string]interface{}{
+				"identity_provider_id": oktaID,
+				"name":                 oktaGroupsByIDP[oktaID],
+			})
+		}
 		data = append(data, map[string]interface{}{
-			"okta": []interface{}{
-				map[string]interface{}{
-					"identity_provider_id": oktaID,
-					"name":                 oktaGroups,
-				}},
+			"okta": oktaBlocks,
 		})
 	}
 
@@ -225,6 +225,33 @@ func TestAccCloudflareAccessGroupWithIDP(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareAccessGroupWithMultipleOktaGroups(t *testing.T) {
+	rnd := generateRandomResourceName()
+	groupName := fmt.Sprintf("cloudflare_access_group.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessGroupWithMultipleOktaGroups(accountID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareAccessGroupExists(groupName, AccessIdentifier{Type: AccountType, Value: accountID}, &accessGroup),
+					resource.TestCheckResourceAttrSet(groupName, "include.0.okta.0.identity_provider_id"),
+					resource.TestCheckResourceAttr(groupName, "include.0.okta.0.name.#", "3"),
+					resource.TestCheckResourceAttr(groupName, "include.0.okta.0.name.0", "engineering"),
+					resource.TestCheckResourceAttr(groupName, "include.0.okta.0.name.1", "sre"),
+					resource.TestCheckResourceAttr(groupName, "include.0.okta.0.name.2", "support"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareAccessGroup_Updated(t *testing.T) {
 	var before, after cloudflare.AccessGroup
 	rnd := generateRandomResourceName()
@@ -394,6 +421,32 @@ resource "cloudflare_access_group" "%[2]s" {
 }`, accountID, rnd, githubOrg, team)
 }
 
+func testAccCloudflareAccessGroupWithMultipleOktaGroups(accountID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_identity_provider" "%[2]s" {
+  account_id = "%[1]s"
+  name = "%[2]s"
+  type = "okta"
+  config {
+    client_id = "test"
+    client_secret = "secret"
+    okta_account = "https://example.cloudflareaccess.com"
+  }
+}
+
+resource "cloudflare_access_group" "%[2]s" {
+  account_id = "%[1]s"
+  name = "%[2]s"
+
+  include {
+    okta {
+      name                 = ["engineering", "sre", "support"]
+      identity_provider_id = cloudflare_access_identity_provider.%[2]s.id
+    }
+  }
+}`, accountID, rnd)
+}
+
 func testAccCheckCloudflareAccessGroupExists(n string, accessIdentifier AccessIdentifier, accessGroup *cloudflare.AccessGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -488,3 +541,61 @@ func testAccCheckCloudflareAccessGroupRecreated(before, after *cloudflare.Access
 		return nil
 	}
 }
+
+func TestBuildAccessGroupConditionInvalidValues(t *testing.T) {
+	// A null element in a list of strings must surface an error instead of
+	// panicking inside the provider.
+	_, err := BuildAccessGroupCondition(map[string]interface{}{
+		"email": []interface{}{"test@example.com", nil},
+	})
+	if err == nil {
+		t.Fatal("expected an error for a null email element, got none")
+	}
+
+	// Identity provider backed blocks without an `identity_provider_id`
+	// must also error rather than panic.
+	_, err = BuildAccessGroupCondition(map[string]interface{}{
+		"okta": []interface{}{map[string]interface{}{
+			"name": []interface{}{"engineering"},
+		}},
+	})
+	if err == nil {
+		t.Fatal("expected an error for a missing identity_provider_id, got none")
+	}
+}
+
+func TestTransformAccessGroupForSchemaMultipleOktaGroups(t *testing.T) {
+	// The API returns one okta condition per group name; all names under the
+	// same identity provider must round-trip into a single okta block, and
+	// separate providers must keep separate blocks.
+	groups := TransformAccessGroupForSchema(context.Background(), []interface{}{
+		map[string]interface{}{"okta": map[string]interface{}{"identity_provider_id": "idp-1", "name": "engineering"}},
+		map[string]interface{}{"okta": map[string]interface{}{"identity_provider_id": "idp-1", "name": "sre"}},
+		map[string]interface{}{"okta": map[string]interface{}{"identity_provider_id": "idp-2", "name": []interface{}{"support", "sales"}}},
+	})
+
+	if len(groups) != 1 {
+		t.Fatalf("expected a single condition map, got %d", len(groups))
+	}
+
+	blocks := groups[0]["okta"].([]interface{})
+	if len(blocks) != 2 {
+		t.Fatalf("expected two okta blocks, got %d", len(blocks))
+	}
+
+	first := blocks[0].(map[string]interface{})
+	if first["identity_provider_id"] != "idp-1" {
+		t.Fatalf("expected first block to be for idp-1, got %#v", first["identity_provider_id"])
+	}
+	if names := first["name"].([]string); len(names) != 2 || names[0] != "engineering" || names[1] != "sre" {
+		t.Fatalf("unexpected group names for idp-1: %#v", names)
+	}
+
+	second := blocks[1].(map[string]interface{})
+	if second["identity_provider_id"] != "idp-2" {
+		t.Fatalf("expected second block to be for idp-2, got %#v", second["identity_provider_id"])
+	}
+	if names := second["name"].([]string); len(names) != 2 || names[0] != "support" || names[1] != "sales" {
+		t.Fatalf("unexpected group names for idp-2: %#v", names)
+	}
+}
@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -25,6 +27,23 @@ func resourceCloudflareAccessPolicy() *schema.Resource {
 	}
 }
 
+// accessPolicyModel extends the pinned client's policy type with the
+// session_duration field used for time-bounded access after approval, which
+// the client does not yet model. Requests go through client.Raw so the field
+// reaches the API.
+type accessPolicyModel struct {
+	cloudflare.AccessPolicy
+	SessionDuration *string `json:"session_duration,omitempty"`
+}
+
+func accessPolicyEndpoint(identifier *AccessIdentifier, appID, policyID string) string {
+	endpoint := fmt.Sprintf("/%ss/%s/access/apps/%s/policies", identifier.Type, identifier.Value, appID)
+	if policyID != "" {
+		endpoint += "/" + policyID
+	}
+	return endpoint
+}
+
 func apiAccessPolicyApprovalGroupToSchema(approvalGroup cloudflare.AccessApprovalGroup) map[string]interface{} {
 	data := make(map[string]interface{})
 	data["approvals_needed"] = approvalGroup.ApprovalsNeeded
@@ -61,12 +80,7 @@ func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	var accessPolicy cloudflare.AccessPolicy
-	if identifier.Type == AccountType {
-		accessPolicy, err = client.AccessPolicy(ctx, identifier.Value, appID, d.Id())
-	} else {
-		accessPolicy, err = client.ZoneLevelAccessPolicy(ctx, identifier.Value, appID, d.Id())
-	}
+	res, err := client.Raw(http.MethodGet, accessPolicyEndpoint(identifier, appID, d.Id()), nil)
 	if err != nil {
 		if strings.Contains(err.Error(), "HTTP status 404") {
 			tflog.Info(ctx, fmt.Sprintf("Access Policy %s no longer exists", d.Id()))
@@ -76,6 +90,11 @@ func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("error finding Access Policy %q: %w", d.Id(), err))
 	}
 
+	var accessPolicy accessPolicyModel
+	if err := json.Unmarshal(res, &accessPolicy); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Access Policy: %w", err))
+	}
+
 	d.Set("name", accessPolicy.Name)
 	d.Set("decision", accessPolicy.Decision)
 	d.Set("precedence", accessPolicy.Precedence)
@@ -104,6 +123,10 @@ func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceD
 		d.Set("approval_required", accessPolicy.ApprovalRequired)
 	}
 
+	if accessPolicy.SessionDuration != nil {
+		d.Set("session_duration", accessPolicy.SessionDuration)
+	}
+
 	if len(accessPolicy.ApprovalGroups) != 0 {
 		approvalGroups := make([]map[string]interface{}, 0, len(accessPolicy.ApprovalGroups))
 		for _, apiApprovalGroup := range accessPolicy.ApprovalGroups {
@@ -120,13 +143,16 @@ func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceD
 func resourceCloudflareAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	appID := d.Get("application_id").(string)
-	newAccessPolicy := cloudflare.AccessPolicy{
+	newAccessPolicy := accessPolicyModel{AccessPolicy: cloudflare.AccessPolicy{
 		Name:       d.Get("name").(string),
 		Precedence: d.Get("precedence").(int),
 		Decision:   d.Get("decision").(string),
-	}
+	}}
 
-	newAccessPolicy = appendConditionalAccessPolicyFields(newAccessPolicy, d)
+	newAccessPolicy, err := appendConditionalAccessPolicyFields(newAccessPolicy, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Access Policy from struct: %+v", newAccessPolicy))
 
@@ -135,14 +161,14 @@ func resourceCloudflareAccessPolicyCreate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	var accessPolicy cloudflare.AccessPolicy
-	if identifier.Type == AccountType {
-		accessPolicy, err = client.CreateAccessPolicy(ctx, identifier.Value, appID, newAccessPolicy)
-	} else {
-		accessPolicy, err = client.CreateZoneLevelAccessPolicy(ctx, identifier.Value, appID, newAccessPolicy)
-	}
+	res, err := client.Raw(http.MethodPost, accessPolicyEndpoint(identifier, appID, ""), newAccessPolicy)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Access Policy for ID %q: %w", accessPolicy.ID, err))
+		return diag.FromErr(fmt.Errorf("error creating Access Policy for application %q: %w", appID, err))
+	}
+
+	var accessPolicy accessPolicyModel
+	if err := json.Unmarshal(res, &accessPolicy); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Access Policy: %w", err))
 	}
 
 	d.SetId(accessPolicy.ID)
@@ -153,14 +179,17 @@ func resourceCloudflareAccessPolicyCreate(ctx context.Context, d *schema.Resourc
 func resourceCloudflareAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	appID := d.Get("application_id").(string)
-	updatedAccessPolicy := cloudflare.AccessPolicy{
+	updatedAccessPolicy := accessPolicyModel{AccessPolicy: cloudflare.AccessPolicy{
 		Name:       d.Get("name").(string),
 		Precedence: d.Get("precedence").(int),
 		Decision:   d.Get("decision").(string),
 		ID:         d.Id(),
-	}
+	}}
 
-	updatedAccessPolicy = appendConditionalAccessPolicyFields(updatedAccessPolicy, d)
+	updatedAccessPolicy, err := appendConditionalAccessPolicyFields(updatedAccessPolicy, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Access Policy from struct: %+v", updatedAccessPolicy))
 
@@ -169,16 +198,16 @@ func resourceCloudflareAccessPolicyUpdate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	var accessPolicy cloudflare.AccessPolicy
-	if identifier.Type == AccountType {
-		accessPolicy, err = client.UpdateAccessPolicy(ctx, identifier.Value, appID, updatedAccessPolicy)
-	} else {
-		accessPolicy, err = client.UpdateZoneLevelAccessPolicy(ctx, identifier.Value, appID, updatedAccessPolicy)
-	}
+	res, err := client.Raw(http.MethodPut, accessPolicyEndpoint(identifier, appID, d.Id()), updatedAccessPolicy)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Access Policy for ID %q: %w", d.Id(), err))
 	}
 
+	var accessPolicy accessPolicyModel
+	if err := json.Unmarshal(res, &accessPolicy); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Access Policy: %w", err))
+	}
+
 	if accessPolicy.ID == "" {
 		return diag.FromErr(fmt.Errorf("failed to find Access Policy ID in update response; resource was empty"))
 	}
@@ -241,25 +270,37 @@ func resourceCloudflareAccessPolicyImport(ctx context.Context, d *schema.Resourc
 // conditional policy enforcement fields it should append to the
 // AccessPolicy by iterating over the provided values and generating the
 // correct structs.
-func appendConditionalAccessPolicyFields(policy cloudflare.AccessPolicy, d *schema.ResourceData) cloudflare.AccessPolicy {
+func appendConditionalAccessPolicyFields(policy accessPolicyModel, d *schema.ResourceData) (accessPolicyModel, error) {
 	exclude := d.Get("exclude").([]interface{})
 	for _, value := range exclude {
 		if value != nil {
-			policy.Exclude = BuildAccessGroupCondition(value.(map[string]interface{}))
+			condition, err := BuildAccessGroupCondition(value.(map[string]interface{}))
+			if err != nil {
+				return policy, fmt.Errorf("failed to build exclude condition: %w", err)
+			}
+			policy.Exclude = condition
 		}
 	}
 
 	require := d.Get("require").([]interface{})
 	for _, value := range require {
 		if value != nil {
-			policy.Require = BuildAccessGroupCondition(value.(map[string]interface{}))
+			condition, err := BuildAccessGroupCondition(value.(map[string]interface{}))
+			if err != nil {
+				return policy, fmt.Errorf("failed to build require condition: %w", err)
+			}
+			policy.Require = condition
 		}
 	}
 
 	include := d.Get("include").([]interface{})
 	for _, value := range include {
 		if value != nil {
-			policy.Include = BuildAccessGroupCondition(value.(map[string]interface{}))
+			condition, err := BuildAccessGroupCondition(value.(map[string]interface{}))
+			if err != nil {
+				return policy, fmt.Errorf("failed to build include condition: %w", err)
+			}
+			policy.Include = condition
 		}
 	}
 
@@ -278,5 +319,9 @@ func appendConditionalAccessPolicyFields(policy cloudflare.AccessPolicy, d *sche
 		policy.ApprovalGroups = append(policy.ApprovalGroups, schemaAccessPolicyApprovalGroupToAPI(approvalGroupAsMap))
 	}
 
-	return policy
+	if sessionDuration, ok := d.GetOk("session_duration"); ok {
+		policy.SessionDuration = cloudflare.StringPtr(sessionDuration.(string))
+	}
+
+	return policy, nil
 }
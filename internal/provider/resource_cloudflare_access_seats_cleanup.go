@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareAccessSeatsCleanup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareAccessSeatsCleanupCreate,
+		ReadContext:   resourceCloudflareAccessSeatsCleanupRead,
+		UpdateContext: resourceCloudflareAccessSeatsCleanupCreate,
+		DeleteContext: resourceCloudflareAccessSeatsCleanupDelete,
+		Schema:        resourceCloudflareAccessSeatsCleanupSchema(),
+	}
+}
+
+func resourceCloudflareAccessSeatsCleanupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"inactive_days": {
+			Description:  "The number of days a user must have been inactive (based on their last successful login) before their seat is revoked.",
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"rerun_trigger": {
+			Description: "Arbitrary map of values that, when changed, will re-run the cleanup.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+		},
+		"revoked_seat_uids": {
+			Description: "The seat UIDs revoked by the last cleanup run.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"last_run": {
+			Description: "RFC3339 timestamp of the last cleanup run.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+// accessSeatUpdate is the body of the update Access seats endpoint, which the
+// pinned client does not yet model.
+type accessSeatUpdate struct {
+	SeatUID     string `json:"seat_uid"`
+	AccessSeat  bool   `json:"access_seat"`
+	GatewaySeat bool   `json:"gateway_seat"`
+}
+
+func resourceCloudflareAccessSeatsCleanupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	inactiveDays := d.Get("inactive_days").(int)
+
+	users, err := listAccessUsers(client, accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -inactiveDays)
+
+	var updates []accessSeatUpdate
+	var revokedSeatUids []string
+	for _, user := range users {
+		hasSeat := (user.AccessSeat != nil && *user.AccessSeat) || (user.GatewaySeat != nil && *user.GatewaySeat)
+		if !hasSeat || user.SeatUID == "" || user.LastSuccessfulLogin == "" {
+			continue
+		}
+
+		lastLogin, err := time.Parse(time.RFC3339, user.LastSuccessfulLogin)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error parsing last successful login of Access user %q: %w", user.ID, err))
+		}
+
+		if lastLogin.Before(cutoff) {
+			updates = append(updates, accessSeatUpdate{SeatUID: user.SeatUID})
+			revokedSeatUids = append(revokedSeatUids, user.SeatUID)
+		}
+	}
+
+	if len(updates) > 0 {
+		tflog.Info(ctx, fmt.Sprintf("Revoking %d Access seats inactive for more than %d days", len(updates), inactiveDays))
+
+		if _, err := client.Raw(http.MethodPatch, fmt.Sprintf("/accounts/%s/access/seats", accountID), updates); err != nil {
+			return diag.FromErr(fmt.Errorf("error revoking Access seats: %w", err))
+		}
+	}
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/access_seats_cleanup", accountID)))
+	d.Set("revoked_seat_uids", revokedSeatUids)
+	d.Set("last_run", time.Now().UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+// The cleanup is a one-shot action. There is no remote state to refresh, so
+// reading keeps whatever the last run recorded.
+func resourceCloudflareAccessSeatsCleanupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// Seats revoked by previous runs are not restored on deletion; the resource
+// is only removed from state.
+func resourceCloudflareAccessSeatsCleanupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAccessSeatsCleanup(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_seats_cleanup.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessSeatsCleanupConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "inactive_days", "90"),
+					resource.TestCheckResourceAttrSet(name, "last_run"),
+					resource.TestCheckResourceAttrSet(name, "revoked_seat_uids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccessSeatsCleanupConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_access_seats_cleanup" "%[1]s" {
+		account_id    = "%[2]s"
+		inactive_days = 90
+	}`, name, accountID)
+}
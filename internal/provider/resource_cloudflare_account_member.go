@@ -54,6 +54,7 @@ func resourceCloudflareAccountMemberRead(ctx context.Context, d *schema.Resource
 	d.Set("account_id", accountID)
 	d.Set("email_address", member.User.Email)
 	d.Set("role_ids", memberIDs)
+	d.Set("status", member.Status)
 	d.SetId(d.Id())
 
 	return nil
@@ -100,18 +101,43 @@ func resourceCloudflareAccountMemberCreate(ctx context.Context, d *schema.Resour
 
 func resourceCloudflareAccountMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
-	accountRoles := []cloudflare.AccountRole{}
-	memberRoles := d.Get("role_ids").(*schema.Set).List()
 
-	for _, r := range memberRoles {
-		accountRole, _ := client.AccountRole(ctx, client.AccountID, r.(string))
-		accountRoles = append(accountRoles, accountRole)
+	if d.HasChange("resend_invite") && d.Get("resend_invite").(string) != "" {
+		member, err := client.AccountMember(ctx, client.AccountID, d.Id())
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to read Cloudflare account member before re-sending invitation: %w", err))
+		}
+
+		if member.Status == "pending" {
+			// re-posting the invitation re-sends the email and restarts the
+			// expiry window of the pending invitation
+			var accountMemberRoleIDs []string
+			for _, roleID := range d.Get("role_ids").(*schema.Set).List() {
+				accountMemberRoleIDs = append(accountMemberRoleIDs, roleID.(string))
+			}
+
+			if _, err := client.CreateAccountMember(ctx, client.AccountID, d.Get("email_address").(string), accountMemberRoleIDs); err != nil {
+				return diag.FromErr(fmt.Errorf("failed to re-send Cloudflare account member invitation: %w", err))
+			}
+		} else {
+			tflog.Info(ctx, fmt.Sprintf("Skipping invitation resend for account member ID %s: membership status is %q, not pending", d.Id(), member.Status))
+		}
 	}
 
-	updatedAccountMember := cloudflare.AccountMember{Roles: accountRoles}
-	_, err := client.UpdateAccountMember(ctx, client.AccountID, d.Id(), updatedAccountMember)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Cloudflare account member: %w", err))
+	if d.HasChange("role_ids") {
+		accountRoles := []cloudflare.AccountRole{}
+		memberRoles := d.Get("role_ids").(*schema.Set).List()
+
+		for _, r := range memberRoles {
+			accountRole, _ := client.AccountRole(ctx, client.AccountID, r.(string))
+			accountRoles = append(accountRoles, accountRole)
+		}
+
+		updatedAccountMember := cloudflare.AccountMember{Roles: accountRoles}
+		_, err := client.UpdateAccountMember(ctx, client.AccountID, d.Id(), updatedAccountMember)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to update Cloudflare account member: %w", err))
+		}
 	}
 
 	return resourceCloudflareAccountMemberRead(ctx, d, meta)
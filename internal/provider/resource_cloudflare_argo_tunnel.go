@@ -16,10 +16,11 @@ const argoTunnelCNAME = "cfargotunnel.com"
 
 func resourceCloudflareArgoTunnel() *schema.Resource {
 	return &schema.Resource{
-		Schema:        resourceCloudflareArgoTunnelSchema(),
-		CreateContext: resourceCloudflareArgoTunnelCreate,
-		ReadContext:   resourceCloudflareArgoTunnelRead,
-		DeleteContext: resourceCloudflareArgoTunnelDelete,
+		DeprecationMessage: "`cloudflare_argo_tunnel` is deprecated and will be removed in the next major version. Use `cloudflare_tunnel` instead and update your state with `terraform state mv`.",
+		Schema:             resourceCloudflareArgoTunnelSchema(),
+		CreateContext:      resourceCloudflareArgoTunnelCreate,
+		ReadContext:        resourceCloudflareArgoTunnelRead,
+		DeleteContext:      resourceCloudflareArgoTunnelDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareArgoTunnelImport,
 		},
@@ -34,6 +35,16 @@ func resourceCloudflareArgoTunnelCreate(ctx context.Context, d *schema.ResourceD
 
 	tunnel, err := client.CreateArgoTunnel(ctx, accID, name, secret)
 	if err != nil {
+		// The create may have gone through even though the response never
+		// arrived. Re-check for the tunnel by name before failing so a retried
+		// apply adopts it instead of creating a duplicate.
+		if isRequestTimeoutError(err) {
+			if existing, lookupErr := findArgoTunnelByName(ctx, client, accID, name); lookupErr == nil && existing != nil {
+				tflog.Info(ctx, fmt.Sprintf("create request for Argo Tunnel %q timed out but the tunnel exists, adopting %s", name, existing.ID))
+				d.SetId(existing.ID)
+				return resourceCloudflareArgoTunnelRead(ctx, d, meta)
+			}
+		}
 		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("failed to create Argo Tunnel")))
 	}
 
@@ -42,6 +53,24 @@ func resourceCloudflareArgoTunnelCreate(ctx context.Context, d *schema.ResourceD
 	return resourceCloudflareArgoTunnelRead(ctx, d, meta)
 }
 
+// findArgoTunnelByName returns the active tunnel with the given name, or nil
+// if no such tunnel exists.
+func findArgoTunnelByName(ctx context.Context, client *cloudflare.API, accID, name string) (*cloudflare.ArgoTunnel, error) {
+	tunnels, err := client.ArgoTunnels(ctx, accID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tunnel := range tunnels {
+		if tunnel.Name == name && tunnel.DeletedAt == nil {
+			tunnel := tunnel
+			return &tunnel, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func resourceCloudflareArgoTunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accID := d.Get("account_id").(string)
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+func resourceCloudflareBYOIPLOADocument() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareBYOIPLOADocumentSchema(),
+		CreateContext: resourceCloudflareBYOIPLOADocumentCreate,
+		ReadContext:   resourceCloudflareBYOIPLOADocumentRead,
+		DeleteContext: resourceCloudflareBYOIPLOADocumentDelete,
+	}
+}
+
+// byoIPLOADocument represents an uploaded Letter of Authorization, which the
+// pinned client does not yet model.
+type byoIPLOADocument struct {
+	ID        string `json:"id"`
+	Filename  string `json:"filename,omitempty"`
+	SizeBytes int    `json:"size_bytes,omitempty"`
+	Created   string `json:"created,omitempty"`
+}
+
+func byoIPLOADocumentEndpoint(accountID, suffix string) string {
+	endpoint := fmt.Sprintf("/accounts/%s/addressing/loa_documents", accountID)
+	if suffix != "" {
+		endpoint += "/" + suffix
+	}
+	return endpoint
+}
+
+func uploadBYOIPLOADocument(ctx context.Context, client *cloudflare.API, accountID, filename string, document []byte) (byoIPLOADocument, error) {
+	var loaDocument byoIPLOADocument
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("loa_document", filename)
+	if err != nil {
+		return loaDocument, err
+	}
+	if _, err := part.Write(document); err != nil {
+		return loaDocument, err
+	}
+	if err := writer.Close(); err != nil {
+		return loaDocument, err
+	}
+
+	res, err := doAuthenticatedHTTPRequest(ctx, client, http.MethodPost, byoIPLOADocumentEndpoint(accountID, ""), writer.FormDataContentType(), body)
+	if err != nil {
+		return loaDocument, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return loaDocument, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return loaDocument, fmt.Errorf("HTTP status %d: %s", res.StatusCode, string(resBody))
+	}
+
+	var envelope struct {
+		Result byoIPLOADocument `json:"result"`
+	}
+	if err := json.Unmarshal(resBody, &envelope); err != nil {
+		return loaDocument, err
+	}
+
+	return envelope.Result, nil
+}
+
+func resourceCloudflareBYOIPLOADocumentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	filename := d.Get("filename").(string)
+
+	document, err := base64.StdEncoding.DecodeString(d.Get("content_base64").(string))
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error decoding LOA document contents"))
+	}
+
+	loaDocument, err := uploadBYOIPLOADocument(ctx, client, accountID, filename, document)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error uploading LOA document"))
+	}
+
+	d.SetId(loaDocument.ID)
+	d.Set("created", loaDocument.Created)
+	d.Set("size_bytes", loaDocument.SizeBytes)
+
+	return resourceCloudflareBYOIPLOADocumentRead(ctx, d, meta)
+}
+
+func resourceCloudflareBYOIPLOADocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	// The download endpoint returns the raw PDF rather than a JSON envelope,
+	// so existence is all that is checked here. The document itself is
+	// immutable once uploaded.
+	res, err := doAuthenticatedHTTPRequest(ctx, client, http.MethodGet, byoIPLOADocumentEndpoint(accountID, d.Id()+"/download"), "", nil)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading LOA document %q", d.Id())))
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		tflog.Info(ctx, fmt.Sprintf("LOA document %s no longer exists", d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return diag.FromErr(fmt.Errorf("error reading LOA document %q: HTTP status %d", d.Id(), res.StatusCode))
+	}
+
+	return nil
+}
+
+// LOA documents cannot be deleted once uploaded, so deletion only removes the
+// document from state.
+func resourceCloudflareBYOIPLOADocumentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareBYOIPLOADocument(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_byo_ip_loa_document.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareBYOIPLOADocumentConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "filename", rnd+".pdf"),
+					resource.TestCheckResourceAttrSet(name, "created"),
+					resource.TestCheckResourceAttrSet(name, "size_bytes"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareBYOIPLOADocumentConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_byo_ip_loa_document" "%[1]s" {
+		account_id     = "%[2]s"
+		filename       = "%[1]s.pdf"
+		content_base64 = "JVBERi0xLjQKJSBtaW5pbWFsIExPQSBkb2N1bWVudAo="
+	}`, name, accountID)
+}
@@ -20,6 +20,7 @@ func resourceCloudflareCertificatePack() *schema.Resource {
 		Schema:        resourceCloudflareCertificatePackSchema(),
 		CreateContext: resourceCloudflareCertificatePackCreate,
 		ReadContext:   resourceCloudflareCertificatePackRead,
+		UpdateContext: resourceCloudflareCertificatePackUpdate,
 		DeleteContext: resourceCloudflareCertificatePackDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareCertificatePackImport,
@@ -66,23 +67,7 @@ func resourceCloudflareCertificatePackCreate(ctx context.Context, d *schema.Reso
 	}
 
 	if d.Get("wait_for_active_status").(bool) {
-		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate)-time.Minute, func() *resource.RetryError {
-			certificatePack, err := client.CertificatePack(ctx, zoneID, certificatePackID)
-			if err != nil {
-				return resource.NonRetryableError(errors.Wrap(err, "failed to fetch certificate pack"))
-			}
-			if len(certificatePack.Certificates) == 0 {
-				return resource.RetryableError(fmt.Errorf("certificate list in response is empty"))
-			}
-			for _, certificate := range certificatePack.Certificates {
-				if certificate.Status != "active" {
-					return resource.RetryableError(fmt.Errorf("expected all certificates in certificate pack to be active state but certificate %s was in state %s", certificate.ID, certificate.Status))
-				}
-			}
-			return nil
-		})
-
-		if err != nil {
+		if err := waitForCertificatePackActive(ctx, client, zoneID, certificatePackID, d.Timeout(schema.TimeoutCreate)-time.Minute); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -92,6 +77,47 @@ func resourceCloudflareCertificatePackCreate(ctx context.Context, d *schema.Reso
 	return resourceCloudflareCertificatePackRead(ctx, d, meta)
 }
 
+func waitForCertificatePackActive(ctx context.Context, client *cloudflare.API, zoneID, certificatePackID string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		certificatePack, err := client.CertificatePack(ctx, zoneID, certificatePackID)
+		if err != nil {
+			return resource.NonRetryableError(errors.Wrap(err, "failed to fetch certificate pack"))
+		}
+		if len(certificatePack.Certificates) == 0 {
+			return resource.RetryableError(fmt.Errorf("certificate list in response is empty"))
+		}
+		for _, certificate := range certificatePack.Certificates {
+			if certificate.Status != "active" {
+				return resource.RetryableError(fmt.Errorf("expected all certificates in certificate pack to be active state but certificate %s was in state %s", certificate.ID, certificate.Status))
+			}
+		}
+		return nil
+	})
+}
+
+func resourceCloudflareCertificatePackUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	if d.HasChange("revalidate") && d.Get("revalidate").(string) != "" {
+		if d.Get("type").(string) != "advanced" {
+			return diag.FromErr(fmt.Errorf("revalidation is only supported for advanced certificate packs"))
+		}
+
+		if _, err := client.RestartAdvancedCertificateValidation(ctx, zoneID, d.Id()); err != nil {
+			return diag.FromErr(errors.Wrap(err, "failed to restart certificate pack validation"))
+		}
+
+		if d.Get("wait_for_active_status").(bool) {
+			if err := waitForCertificatePackActive(ctx, client, zoneID, d.Id(), d.Timeout(schema.TimeoutUpdate)-time.Minute); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceCloudflareCertificatePackRead(ctx, d, meta)
+}
+
 func resourceCloudflareCertificatePackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
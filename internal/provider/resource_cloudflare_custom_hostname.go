@@ -2,13 +2,16 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"reflect"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -20,6 +23,7 @@ func resourceCloudflareCustomHostname() *schema.Resource {
 		ReadContext:   resourceCloudflareCustomHostnameRead,
 		UpdateContext: resourceCloudflareCustomHostnameUpdate,
 		DeleteContext: resourceCloudflareCustomHostnameDelete,
+		CustomizeDiff: resourceCloudflareCustomHostnameDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareCustomHostnameImport,
 		},
@@ -39,6 +43,11 @@ func resourceCloudflareCustomHostnameRead(ctx context.Context, d *schema.Resourc
 	d.Set("hostname", customHostname.Hostname)
 	d.Set("custom_origin_server", customHostname.CustomOriginServer)
 	d.Set("custom_origin_sni", customHostname.CustomOriginSNI)
+	d.Set("status", customHostname.Status)
+
+	if customHostname.CustomMetadata != nil {
+		d.Set("custom_metadata", map[string]interface{}(*customHostname.CustomMetadata))
+	}
 	var sslConfig []map[string]interface{}
 
 	if !reflect.ValueOf(customHostname.SSL).IsNil() {
@@ -105,9 +114,46 @@ func resourceCloudflareCustomHostnameRead(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(fmt.Errorf("failed to set ownership_verification_http: %w", err))
 	}
 
+	dcvDelegationUUID, err := getDCVDelegationUUID(client, zoneID)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading DCV delegation UUID of zone %q", zoneID)))
+	}
+	dcvDelegationRecords := []map[string]interface{}{}
+	if dcvDelegationUUID != "" {
+		dcvDelegationRecords = append(dcvDelegationRecords, map[string]interface{}{
+			"cname":        fmt.Sprintf("_acme-challenge.%s", customHostname.Hostname),
+			"cname_target": fmt.Sprintf("%s.%s.dcv.cloudflare.com", customHostname.Hostname, dcvDelegationUUID),
+		})
+	}
+	if err := d.Set("dcv_delegation_records", dcvDelegationRecords); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set dcv_delegation_records: %w", err))
+	}
+
 	return nil
 }
 
+// getDCVDelegationUUID fetches the zone scoped UUID that DCV delegation CNAME
+// targets are built from. Zones without access to DCV delegation return a 404
+// which is reported as an empty UUID.
+func getDCVDelegationUUID(client *cloudflare.API, zoneID string) (string, error) {
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/zones/%s/dcv_delegation/uuid", zoneID), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			return "", nil
+		}
+		return "", err
+	}
+
+	var result struct {
+		UUID string `json:"uuid"`
+	}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return "", fmt.Errorf("failed to unmarshal DCV delegation UUID: %w", err)
+	}
+
+	return result.UUID, nil
+}
+
 func resourceCloudflareCustomHostnameDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
@@ -134,20 +180,92 @@ func resourceCloudflareCustomHostnameCreate(ctx context.Context, d *schema.Resou
 
 	d.SetId(newCertificate.Result.ID)
 
+	if d.Get("wait_for_ssl_pending_validation").(bool) && certificate.SSL != nil {
+		if err := waitForCustomHostnameCertificate(ctx, d, client, zoneID, newCertificate.Result.ID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("wait_for_active").(bool) {
+		if err := waitForCustomHostnameActive(ctx, d, client, zoneID, newCertificate.Result.ID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceCloudflareCustomHostnameRead(ctx, d, meta)
 }
 
+// waitForCustomHostnameCertificate polls the custom hostname until the SSL
+// sub-object has left the initializing state, i.e. the certificate has been
+// issued or renewed and the validation records (if any) are available.
+func waitForCustomHostnameCertificate(ctx context.Context, d *schema.ResourceData, client *cloudflare.API, zoneID, hostnameID string) error {
+	return resource.RetryContext(ctx, d.Timeout(schema.TimeoutDefault), func() *resource.RetryError {
+		customHostname, err := client.CustomHostname(ctx, zoneID, hostnameID)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("failed to fetch custom hostname: %w", err))
+		}
+
+		if customHostname.SSL == nil || customHostname.SSL.Status == "" || customHostname.SSL.Status == "initializing" {
+			return resource.RetryableError(fmt.Errorf("expected custom hostname certificate to be issued but is still initializing"))
+		}
+
+		return nil
+	})
+}
+
+// waitForCustomHostnameActive polls the custom hostname until both the
+// hostname itself and its SSL sub-object (when present) have reached the
+// active status, i.e. ownership has been verified and the certificate has
+// been deployed to the edge.
+func waitForCustomHostnameActive(ctx context.Context, d *schema.ResourceData, client *cloudflare.API, zoneID, hostnameID string) error {
+	return resource.RetryContext(ctx, d.Timeout(schema.TimeoutDefault), func() *resource.RetryError {
+		customHostname, err := client.CustomHostname(ctx, zoneID, hostnameID)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("failed to fetch custom hostname: %w", err))
+		}
+
+		if customHostname.Status != cloudflare.ACTIVE {
+			return resource.RetryableError(fmt.Errorf("expected custom hostname to be active but was in status %q", customHostname.Status))
+		}
+
+		if customHostname.SSL != nil && customHostname.SSL.Status != "active" {
+			return resource.RetryableError(fmt.Errorf("expected custom hostname certificate to be active but was in status %q", customHostname.SSL.Status))
+		}
+
+		return nil
+	})
+}
+
 func resourceCloudflareCustomHostnameUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
 	hostnameID := d.Id()
 	certificate := buildCustomHostname(d)
 
+	// Only resubmit the SSL configuration when it actually changed, so that
+	// in-place updates of custom_origin_server or custom_origin_sni do not
+	// trigger an unnecessary certificate validation cycle.
+	if !d.HasChange("ssl") {
+		certificate.SSL = nil
+	}
+
 	_, err := client.UpdateCustomHostname(ctx, zoneID, hostnameID, certificate)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "failed to update custom hostname certificate"))
 	}
 
+	if d.Get("wait_for_ssl_pending_validation").(bool) && certificate.SSL != nil {
+		if err := waitForCustomHostnameCertificate(ctx, d, client, zoneID, hostnameID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("wait_for_active").(bool) {
+		if err := waitForCustomHostnameActive(ctx, d, client, zoneID, hostnameID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceCloudflareCustomHostnameRead(ctx, d, meta)
 }
 
@@ -168,6 +286,17 @@ func resourceCloudflareCustomHostnameImport(ctx context.Context, d *schema.Resou
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceCloudflareCustomHostnameDiff rejects combinations the API only
+// fails on at submission time: a domain control validation method only
+// applies to certificates issued by Cloudflare, not to uploaded custom
+// certificates.
+func resourceCloudflareCustomHostnameDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("ssl.0.method").(string) != "" && d.Get("ssl.0.custom_certificate").(string) != "" {
+		return fmt.Errorf(`"ssl.0.method" cannot be combined with "ssl.0.custom_certificate": domain control validation only applies to certificates issued by Cloudflare`)
+	}
+	return nil
+}
+
 // buildCustomHostname takes the existing schema and returns a
 // `cloudflare.CustomHostname`.
 func buildCustomHostname(d *schema.ResourceData) cloudflare.CustomHostname {
@@ -177,6 +306,14 @@ func buildCustomHostname(d *schema.ResourceData) cloudflare.CustomHostname {
 		CustomOriginSNI:    d.Get("custom_origin_sni").(string),
 	}
 
+	if metadata, ok := d.GetOk("custom_metadata"); ok {
+		customMetadata := make(cloudflare.CustomMetadata)
+		for key, value := range metadata.(map[string]interface{}) {
+			customMetadata[key] = value
+		}
+		ch.CustomMetadata = &customMetadata
+	}
+
 	if _, ok := d.GetOk("ssl"); ok {
 		ch.SSL = &cloudflare.CustomHostnameSSL{
 			Method:               d.Get("ssl.0.method").(string),
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// d1Database is the API representation of a D1 database, which the pinned
+// client does not cover. The UUID is the database identifier used in
+// `d1_database_binding` blocks on Worker scripts.
+type d1Database struct {
+	UUID            string             `json:"uuid,omitempty"`
+	Name            string             `json:"name,omitempty"`
+	Version         string             `json:"version,omitempty"`
+	ReadReplication *d1ReadReplication `json:"read_replication,omitempty"`
+}
+
+type d1ReadReplication struct {
+	Mode string `json:"mode"`
+}
+
+func resourceCloudflareD1Database() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareD1DatabaseSchema(),
+		CreateContext: resourceCloudflareD1DatabaseCreate,
+		ReadContext:   resourceCloudflareD1DatabaseRead,
+		UpdateContext: resourceCloudflareD1DatabaseUpdate,
+		DeleteContext: resourceCloudflareD1DatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareD1DatabaseImport,
+		},
+		Description: "Provides a resource which manages a D1 database. The database ID is used by `d1_database_binding` blocks on `cloudflare_worker_script`.",
+	}
+}
+
+func d1DatabaseEndpoint(accountID, databaseID string) string {
+	if databaseID == "" {
+		return fmt.Sprintf("/accounts/%s/d1/database", accountID)
+	}
+	return fmt.Sprintf("/accounts/%s/d1/database/%s", accountID, databaseID)
+}
+
+func resourceCloudflareD1DatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+
+	payload := map[string]interface{}{
+		"name": name,
+	}
+	if hint := d.Get("primary_location_hint").(string); hint != "" {
+		payload["primary_location_hint"] = hint
+	}
+
+	res, err := client.Raw(http.MethodPost, d1DatabaseEndpoint(accountID, ""), payload)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating D1 database %q: %w", name, err))
+	}
+
+	var database d1Database
+	if err := json.Unmarshal(res, &database); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling D1 database: %w", err))
+	}
+
+	d.SetId(database.UUID)
+
+	if mode := d.Get("read_replication_mode").(string); mode != "disabled" {
+		if err := setD1DatabaseReadReplication(client, accountID, database.UUID, mode); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudflareD1DatabaseRead(ctx, d, meta)
+}
+
+func setD1DatabaseReadReplication(client *cloudflare.API, accountID, databaseID, mode string) error {
+	_, err := client.Raw(http.MethodPut, d1DatabaseEndpoint(accountID, databaseID), map[string]interface{}{
+		"read_replication": d1ReadReplication{Mode: mode},
+	})
+	if err != nil {
+		return fmt.Errorf("error setting read replication mode of D1 database %q: %w", databaseID, err)
+	}
+	return nil
+}
+
+func resourceCloudflareD1DatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodGet, d1DatabaseEndpoint(accountID, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading D1 database %q: %w", d.Id(), err))
+	}
+
+	var database d1Database
+	if err := json.Unmarshal(res, &database); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling D1 database: %w", err))
+	}
+
+	// the location hint only steers the initial placement and is not
+	// returned by the API, so it is left as configured
+	d.Set("name", database.Name)
+	d.Set("version", database.Version)
+	if database.ReadReplication != nil {
+		d.Set("read_replication_mode", database.ReadReplication.Mode)
+	}
+
+	return nil
+}
+
+func resourceCloudflareD1DatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	if d.HasChange("read_replication_mode") {
+		if err := setD1DatabaseReadReplication(client, accountID, d.Id(), d.Get("read_replication_mode").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudflareD1DatabaseRead(ctx, d, meta)
+}
+
+func resourceCloudflareD1DatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	_, err := client.Raw(http.MethodDelete, d1DatabaseEndpoint(accountID, d.Id()), nil)
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error deleting D1 database %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareD1DatabaseImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/databaseID\"", d.Id())
+	}
+
+	accountID, databaseID := attributes[0], attributes[1]
+	d.Set("account_id", accountID)
+	d.SetId(databaseID)
+
+	resourceCloudflareD1DatabaseRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareDNSFirewall() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareDNSFirewallCreate,
+		ReadContext:   resourceCloudflareDNSFirewallRead,
+		UpdateContext: resourceCloudflareDNSFirewallUpdate,
+		DeleteContext: resourceCloudflareDNSFirewallDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareDNSFirewallImport,
+		},
+		Schema: resourceCloudflareDNSFirewallSchema(),
+	}
+}
+
+// dnsFirewallCluster is the account-scoped API representation of a DNS
+// Firewall cluster. The pinned client only models the user-scoped endpoint
+// and predates the ratelimit and ECS fallback settings.
+type dnsFirewallCluster struct {
+	ID                   string   `json:"id,omitempty"`
+	Name                 string   `json:"name"`
+	UpstreamIPs          []string `json:"upstream_ips"`
+	DNSFirewallIPs       []string `json:"dns_firewall_ips,omitempty"`
+	MinimumCacheTTL      int      `json:"minimum_cache_ttl,omitempty"`
+	MaximumCacheTTL      int      `json:"maximum_cache_ttl,omitempty"`
+	DeprecateAnyRequests *bool    `json:"deprecate_any_requests,omitempty"`
+	ECSFallback          *bool    `json:"ecs_fallback,omitempty"`
+	Ratelimit            int      `json:"ratelimit,omitempty"`
+	ModifiedOn           string   `json:"modified_on,omitempty"`
+}
+
+func dnsFirewallEndpoint(accountID, clusterID string) string {
+	if clusterID == "" {
+		return fmt.Sprintf("/accounts/%s/dns_firewall", accountID)
+	}
+	return fmt.Sprintf("/accounts/%s/dns_firewall/%s", accountID, clusterID)
+}
+
+func dnsFirewallClusterFromResource(d *schema.ResourceData) dnsFirewallCluster {
+	return dnsFirewallCluster{
+		Name:                 d.Get("name").(string),
+		UpstreamIPs:          expandInterfaceToStringList(d.Get("upstream_ips").(*schema.Set).List()),
+		MinimumCacheTTL:      d.Get("minimum_cache_ttl").(int),
+		MaximumCacheTTL:      d.Get("maximum_cache_ttl").(int),
+		DeprecateAnyRequests: cloudflare.BoolPtr(d.Get("deprecate_any_requests").(bool)),
+		ECSFallback:          cloudflare.BoolPtr(d.Get("ecs_fallback").(bool)),
+		Ratelimit:            d.Get("ratelimit").(int),
+	}
+}
+
+func resourceCloudflareDNSFirewallCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	cluster := dnsFirewallClusterFromResource(d)
+
+	res, err := client.Raw(http.MethodPost, dnsFirewallEndpoint(accountID, ""), cluster)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating DNS Firewall cluster: %w", err))
+	}
+
+	if err := json.Unmarshal(res, &cluster); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding DNS Firewall cluster: %w", err))
+	}
+
+	d.SetId(cluster.ID)
+
+	return resourceCloudflareDNSFirewallRead(ctx, d, meta)
+}
+
+func resourceCloudflareDNSFirewallRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodGet, dnsFirewallEndpoint(accountID, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			tflog.Info(ctx, fmt.Sprintf("DNS Firewall cluster %s not found", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading DNS Firewall cluster %q: %w", d.Id(), err))
+	}
+
+	var cluster dnsFirewallCluster
+	if err := json.Unmarshal(res, &cluster); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding DNS Firewall cluster %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", cluster.Name)
+	d.Set("upstream_ips", cluster.UpstreamIPs)
+	d.Set("dns_firewall_ips", cluster.DNSFirewallIPs)
+	d.Set("minimum_cache_ttl", cluster.MinimumCacheTTL)
+	d.Set("maximum_cache_ttl", cluster.MaximumCacheTTL)
+	d.Set("ratelimit", cluster.Ratelimit)
+	d.Set("modified_on", cluster.ModifiedOn)
+	if cluster.DeprecateAnyRequests != nil {
+		d.Set("deprecate_any_requests", *cluster.DeprecateAnyRequests)
+	}
+	if cluster.ECSFallback != nil {
+		d.Set("ecs_fallback", *cluster.ECSFallback)
+	}
+
+	return nil
+}
+
+func resourceCloudflareDNSFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	cluster := dnsFirewallClusterFromResource(d)
+
+	if _, err := client.Raw(http.MethodPatch, dnsFirewallEndpoint(accountID, d.Id()), cluster); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating DNS Firewall cluster %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareDNSFirewallRead(ctx, d, meta)
+}
+
+func resourceCloudflareDNSFirewallDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting DNS Firewall cluster %s", d.Id()))
+
+	if _, err := client.Raw(http.MethodDelete, dnsFirewallEndpoint(accountID, d.Id()), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting DNS Firewall cluster %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareDNSFirewallImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (%q) specified, should be in format \"accountID/clusterID\"", d.Id())
+	}
+
+	d.Set("account_id", attributes[0])
+	d.SetId(attributes[1])
+
+	resourceCloudflareDNSFirewallRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareDNSFirewall(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_dns_firewall.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDNSFirewallConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "upstream_ips.#", "1"),
+					resource.TestCheckResourceAttr(name, "deprecate_any_requests", "true"),
+					resource.TestCheckResourceAttrSet(name, "dns_firewall_ips.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareDNSFirewallConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_dns_firewall" "%[1]s" {
+		account_id             = "%[2]s"
+		name                   = "%[1]s"
+		upstream_ips           = ["192.0.2.53"]
+		deprecate_any_requests = true
+	}`, name, accountID)
+}
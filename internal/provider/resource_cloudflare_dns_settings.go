@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareDNSSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareDNSSettingsUpdate,
+		ReadContext:   resourceCloudflareDNSSettingsRead,
+		UpdateContext: resourceCloudflareDNSSettingsUpdate,
+		DeleteContext: resourceCloudflareDNSSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareDNSSettingsImport,
+		},
+		Schema: resourceCloudflareDNSSettingsSchema(),
+	}
+}
+
+// zoneDNSSettings is the API representation of a zone's DNS settings, which
+// the pinned client does not yet model.
+type zoneDNSSettings struct {
+	FoundationDNS      *bool                       `json:"foundation_dns,omitempty"`
+	MultiProvider      *bool                       `json:"multi_provider,omitempty"`
+	SecondaryOverrides *bool                       `json:"secondary_overrides,omitempty"`
+	NSTTL              int                         `json:"ns_ttl,omitempty"`
+	ZoneMode           string                      `json:"zone_mode,omitempty"`
+	Nameservers        *zoneDNSSettingsNameservers `json:"nameservers,omitempty"`
+	SOA                *zoneDNSSettingsSOA         `json:"soa,omitempty"`
+}
+
+type zoneDNSSettingsNameservers struct {
+	Type string `json:"type"`
+}
+
+type zoneDNSSettingsSOA struct {
+	Expire  int    `json:"expire"`
+	MinTTL  int    `json:"min_ttl"`
+	MName   string `json:"mname"`
+	Refresh int    `json:"refresh"`
+	Retry   int    `json:"retry"`
+	RName   string `json:"rname"`
+	TTL     int    `json:"ttl"`
+}
+
+func resourceCloudflareDNSSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	settings := zoneDNSSettings{
+		NSTTL:    d.Get("ns_ttl").(int),
+		ZoneMode: d.Get("zone_mode").(string),
+	}
+
+	if v, ok := d.GetOkExists("foundation_dns"); ok {
+		settings.FoundationDNS = cloudflare.BoolPtr(v.(bool))
+	}
+	if v, ok := d.GetOkExists("multi_provider"); ok {
+		settings.MultiProvider = cloudflare.BoolPtr(v.(bool))
+	}
+	if v, ok := d.GetOkExists("secondary_overrides"); ok {
+		settings.SecondaryOverrides = cloudflare.BoolPtr(v.(bool))
+	}
+	if v, ok := d.GetOk("nameservers"); ok {
+		cfg := v.([]interface{})[0].(map[string]interface{})
+		settings.Nameservers = &zoneDNSSettingsNameservers{Type: cfg["type"].(string)}
+	}
+	if v, ok := d.GetOk("soa"); ok {
+		cfg := v.([]interface{})[0].(map[string]interface{})
+		settings.SOA = &zoneDNSSettingsSOA{
+			Expire:  cfg["expire"].(int),
+			MinTTL:  cfg["min_ttl"].(int),
+			MName:   cfg["mname"].(string),
+			Refresh: cfg["refresh"].(int),
+			Retry:   cfg["retry"].(int),
+			RName:   cfg["rname"].(string),
+			TTL:     cfg["ttl"].(int),
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating DNS settings for zone %s: %+v", zoneID, settings))
+
+	if _, err := client.Raw(http.MethodPatch, fmt.Sprintf("/zones/%s/dns_settings", zoneID), settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating DNS settings for zone %q: %w", zoneID, err))
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareDNSSettingsRead(ctx, d, meta)
+}
+
+func resourceCloudflareDNSSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Id()
+
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/zones/%s/dns_settings", zoneID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading DNS settings for zone %q: %w", zoneID, err))
+	}
+
+	var settings zoneDNSSettings
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding DNS settings for zone %q: %w", zoneID, err))
+	}
+
+	d.Set("zone_id", zoneID)
+	if settings.FoundationDNS != nil {
+		d.Set("foundation_dns", *settings.FoundationDNS)
+	}
+	if settings.MultiProvider != nil {
+		d.Set("multi_provider", *settings.MultiProvider)
+	}
+	if settings.SecondaryOverrides != nil {
+		d.Set("secondary_overrides", *settings.SecondaryOverrides)
+	}
+	d.Set("ns_ttl", settings.NSTTL)
+	d.Set("zone_mode", settings.ZoneMode)
+
+	if settings.Nameservers != nil {
+		d.Set("nameservers", []interface{}{map[string]interface{}{
+			"type": settings.Nameservers.Type,
+		}})
+	}
+
+	if settings.SOA != nil {
+		d.Set("soa", []interface{}{map[string]interface{}{
+			"expire":  settings.SOA.Expire,
+			"min_ttl": settings.SOA.MinTTL,
+			"mname":   settings.SOA.MName,
+			"refresh": settings.SOA.Refresh,
+			"retry":   settings.SOA.Retry,
+			"rname":   settings.SOA.RName,
+			"ttl":     settings.SOA.TTL,
+		}})
+	}
+
+	return nil
+}
+
+func resourceCloudflareDNSSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// DNS settings cannot be deleted, only modified; removing the resource
+	// leaves the zone with its current settings
+	tflog.Info(ctx, fmt.Sprintf("Leaving DNS settings in place for zone %s", d.Id()))
+	return nil
+}
+
+func resourceCloudflareDNSSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareDNSSettingsRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
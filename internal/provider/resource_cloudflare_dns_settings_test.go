@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareDNSSettings(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_dns_settings.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDNSSettingsConfig(rnd, zoneID, 3600, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "ns_ttl", "3600"),
+					resource.TestCheckResourceAttr(name, "multi_provider", "true"),
+					resource.TestCheckResourceAttr(name, "secondary_overrides", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareDNSSettingsConfig(rnd, zoneID, 86400, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "ns_ttl", "86400"),
+					resource.TestCheckResourceAttr(name, "multi_provider", "false"),
+					resource.TestCheckResourceAttr(name, "secondary_overrides", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareDNSSettingsConfig(name, zoneID string, nsTTL int, enabled bool) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_dns_settings" "%[1]s" {
+		zone_id             = "%[2]s"
+		ns_ttl              = %[3]d
+		multi_provider      = %[4]t
+		secondary_overrides = %[4]t
+	}`, name, zoneID, nsTTL, enabled)
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareDNSZoneExclusiveRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareDNSZoneExclusiveRecordsCreate,
+		ReadContext:   resourceCloudflareDNSZoneExclusiveRecordsRead,
+		UpdateContext: resourceCloudflareDNSZoneExclusiveRecordsUpdate,
+		DeleteContext: resourceCloudflareDNSZoneExclusiveRecordsDelete,
+		Schema:        resourceCloudflareDNSZoneExclusiveRecordsSchema(),
+	}
+}
+
+func resourceCloudflareDNSZoneExclusiveRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zoneID := d.Get("zone_id").(string)
+
+	if err := reconcileZoneExclusiveRecords(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareDNSZoneExclusiveRecordsRead(ctx, d, meta)
+}
+
+func resourceCloudflareDNSZoneExclusiveRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := reconcileZoneExclusiveRecords(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareDNSZoneExclusiveRecordsRead(ctx, d, meta)
+}
+
+func resourceCloudflareDNSZoneExclusiveRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	records, err := client.DNSRecords(ctx, zoneID, cloudflare.DNSRecord{})
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			tflog.Info(ctx, fmt.Sprintf("Zone %s not found", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error listing DNS records for zone %q: %w", zoneID, err))
+	}
+
+	ignores := expandZoneRecordIgnores(d.Get("ignore"))
+
+	flattened := make([]interface{}, 0)
+	for _, r := range records {
+		if zoneRecordIgnored(ignores, r) {
+			continue
+		}
+		record := map[string]interface{}{
+			"name":    r.Name,
+			"type":    r.Type,
+			"value":   r.Content,
+			"ttl":     r.TTL,
+			"proxied": r.Proxied != nil && *r.Proxied,
+		}
+		if r.Priority != nil {
+			record["priority"] = int(*r.Priority)
+		}
+		flattened = append(flattened, record)
+	}
+
+	if err := d.Set("record", flattened); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting record: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareDNSZoneExclusiveRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// destroying this resource only stops Terraform from enforcing the record
+	// set; the records themselves are left in place
+	tflog.Info(ctx, fmt.Sprintf("Leaving DNS records in place for zone %s", d.Get("zone_id").(string)))
+	return nil
+}
+
+// zoneRecordIgnore is a single configured ignore filter. A record matching
+// any filter is left alone during reconciliation.
+type zoneRecordIgnore struct {
+	Name string
+	Type string
+}
+
+func expandZoneRecordIgnores(v interface{}) []zoneRecordIgnore {
+	var ignores []zoneRecordIgnore
+	for _, ele := range v.(*schema.Set).List() {
+		cfg := ele.(map[string]interface{})
+		ignores = append(ignores, zoneRecordIgnore{
+			Name: cfg["name"].(string),
+			Type: cfg["type"].(string),
+		})
+	}
+	return ignores
+}
+
+func zoneRecordIgnored(ignores []zoneRecordIgnore, r cloudflare.DNSRecord) bool {
+	for _, ignore := range ignores {
+		if ignore.Type != "" && ignore.Type != r.Type {
+			continue
+		}
+		if ignore.Name != "" && !zoneRecordNameMatch(ignore.Name, r.Name) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// zoneRecordNameMatch matches a record name against an ignore filter name,
+// supporting a leading "*." wildcard.
+func zoneRecordNameMatch(pattern, name string) bool {
+	if strings.HasPrefix(pattern, "*.") {
+		return strings.HasSuffix(name, pattern[1:])
+	}
+	return pattern == name
+}
+
+// reconcileZoneExclusiveRecords makes the zone's record set match the
+// configured one: missing records are created, records whose TTL, priority or
+// proxy status drifted are updated and records absent from the configuration
+// are deleted unless an ignore filter covers them.
+func reconcileZoneExclusiveRecords(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	existing, err := client.DNSRecords(ctx, zoneID, cloudflare.DNSRecord{})
+	if err != nil {
+		return fmt.Errorf("error listing DNS records for zone %q: %w", zoneID, err)
+	}
+
+	existingByKey := make(map[string]cloudflare.DNSRecord)
+	for _, r := range existing {
+		existingByKey[fmt.Sprintf("%s/%s/%s", r.Type, r.Name, r.Content)] = r
+	}
+
+	ignores := expandZoneRecordIgnores(d.Get("ignore"))
+	desired := make(map[string]cloudflare.DNSRecord)
+
+	for _, ele := range d.Get("record").(*schema.Set).List() {
+		cfg := ele.(map[string]interface{})
+		proxied := cfg["proxied"].(bool)
+		record := cloudflare.DNSRecord{
+			Type:    cfg["type"].(string),
+			Name:    strings.ToLower(cfg["name"].(string)),
+			Content: cfg["value"].(string),
+			TTL:     cfg["ttl"].(int),
+			Proxied: &proxied,
+		}
+		if priority, ok := cfg["priority"]; ok && contains([]string{"MX", "SRV", "URI"}, record.Type) {
+			p := uint16(priority.(int))
+			record.Priority = &p
+		}
+		desired[fmt.Sprintf("%s/%s/%s", record.Type, record.Name, record.Content)] = record
+	}
+
+	// create or refresh everything the configuration declares
+	for key, record := range desired {
+		match, ok := existingByKey[key]
+		if !ok {
+			tflog.Info(ctx, fmt.Sprintf("Creating DNS record %q in zone %s", key, zoneID))
+			if _, err := client.CreateDNSRecord(ctx, zoneID, record); err != nil {
+				return fmt.Errorf("error creating DNS record %q: %w", key, err)
+			}
+			continue
+		}
+
+		proxiedDrift := (match.Proxied != nil && *match.Proxied) != (record.Proxied != nil && *record.Proxied)
+		priorityDrift := match.Priority != nil && record.Priority != nil && *match.Priority != *record.Priority
+		if match.TTL != record.TTL || proxiedDrift || priorityDrift {
+			tflog.Info(ctx, fmt.Sprintf("Updating DNS record %q in zone %s", key, zoneID))
+			if err := client.UpdateDNSRecord(ctx, zoneID, match.ID, record); err != nil {
+				return fmt.Errorf("error updating DNS record %q: %w", key, err)
+			}
+		}
+	}
+
+	// anything else in the zone is unmanaged and gets removed
+	for key, record := range existingByKey {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if zoneRecordIgnored(ignores, record) {
+			tflog.Debug(ctx, fmt.Sprintf("Ignoring unmanaged DNS record %q in zone %s", key, zoneID))
+			continue
+		}
+		tflog.Info(ctx, fmt.Sprintf("Deleting unmanaged DNS record %q from zone %s", key, zoneID))
+		if err := client.DeleteDNSRecord(ctx, zoneID, record.ID); err != nil {
+			return fmt.Errorf("error deleting unmanaged DNS record %q: %w", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareDNSZoneExclusiveRecords_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_dns_zone_exclusive_records.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDomain(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDNSZoneExclusiveRecordsConfig(rnd, zoneID, domain, 300, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "record.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "record.*", map[string]string{
+						"name":    fmt.Sprintf("%s.%s", rnd, domain),
+						"type":    "A",
+						"value":   "192.0.2.10",
+						"ttl":     "300",
+						"proxied": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "record.*", map[string]string{
+						"name":  fmt.Sprintf("_%s.%s", rnd, domain),
+						"type":  "TXT",
+						"value": "exclusive records test",
+					}),
+				),
+			},
+			{
+				// drift-update path: the existing record is updated in
+				// place rather than deleted and recreated
+				Config: testAccCloudflareDNSZoneExclusiveRecordsConfig(rnd, zoneID, domain, 1, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(name, "record.*", map[string]string{
+						"name":    fmt.Sprintf("%s.%s", rnd, domain),
+						"type":    "A",
+						"ttl":     "1",
+						"proxied": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareDNSZoneExclusiveRecords_Ignore(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_dns_zone_exclusive_records.%s", rnd)
+	recordName := fmt.Sprintf("cloudflare_record.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDomain(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDNSZoneExclusiveRecordsConfigIgnore(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "record.#", "1"),
+					resource.TestCheckResourceAttr(name, "ignore.#", "1"),
+					resource.TestCheckResourceAttr(recordName, "value", "undeclared but ignored"),
+				),
+			},
+			{
+				// refresh both resources: the undeclared record must
+				// survive the reconcile because the ignore filter covers it
+				Config:   testAccCloudflareDNSZoneExclusiveRecordsConfigIgnore(rnd, zoneID, domain),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareDNSZoneExclusiveRecordsConfig(name, zoneID, domain string, ttl int, proxied bool) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_dns_zone_exclusive_records" "%[1]s" {
+		zone_id = "%[2]s"
+
+		record {
+			name    = "%[1]s.%[3]s"
+			type    = "A"
+			value   = "192.0.2.10"
+			ttl     = %[4]d
+			proxied = %[5]t
+		}
+
+		record {
+			name  = "_%[1]s.%[3]s"
+			type  = "TXT"
+			value = "exclusive records test"
+		}
+	}`, name, zoneID, domain, ttl, proxied)
+}
+
+func testAccCloudflareDNSZoneExclusiveRecordsConfigIgnore(name, zoneID, domain string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_record" "%[1]s" {
+		zone_id = "%[2]s"
+		name    = "ignored-%[1]s"
+		type    = "TXT"
+		value   = "undeclared but ignored"
+	}
+
+	resource "cloudflare_dns_zone_exclusive_records" "%[1]s" {
+		zone_id = "%[2]s"
+
+		record {
+			name  = "%[1]s.%[3]s"
+			type  = "A"
+			value = "192.0.2.10"
+			ttl   = 300
+		}
+
+		ignore {
+			name = "ignored-%[1]s.%[3]s"
+		}
+
+		depends_on = [cloudflare_record.%[1]s]
+	}`, name, zoneID, domain)
+}
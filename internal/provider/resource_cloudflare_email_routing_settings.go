@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareEmailRoutingSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareEmailRoutingSettingsUpdate,
+		ReadContext:   resourceCloudflareEmailRoutingSettingsRead,
+		UpdateContext: resourceCloudflareEmailRoutingSettingsUpdate,
+		DeleteContext: resourceCloudflareEmailRoutingSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareEmailRoutingSettingsImport,
+		},
+		Schema: resourceCloudflareEmailRoutingSettingsSchema(),
+	}
+}
+
+// emailRoutingSettings is the API representation of a zone's email routing
+// settings, which the pinned client does not yet model.
+type emailRoutingSettings struct {
+	Enabled       *bool  `json:"enabled,omitempty"`
+	SkipWizard    *bool  `json:"skip_wizard,omitempty"`
+	Subaddressing *bool  `json:"subaddressing,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Status        string `json:"status,omitempty"`
+	Tag           string `json:"tag,omitempty"`
+}
+
+// emailRoutingCatchAll is the API representation of the zone's catch-all
+// routing rule, which decides what happens to mail no other rule matches.
+type emailRoutingCatchAll struct {
+	Enabled  *bool `json:"enabled,omitempty"`
+	Matchers []struct {
+		Type string `json:"type"`
+	} `json:"matchers"`
+	Actions []struct {
+		Type  string   `json:"type"`
+		Value []string `json:"value,omitempty"`
+	} `json:"actions"`
+}
+
+func emailRoutingEndpoint(zoneID, suffix string) string {
+	if suffix == "" {
+		return fmt.Sprintf("/zones/%s/email/routing", zoneID)
+	}
+	return fmt.Sprintf("/zones/%s/email/routing/%s", zoneID, suffix)
+}
+
+func resourceCloudflareEmailRoutingSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	action := "disable"
+	if d.Get("enabled").(bool) {
+		action = "enable"
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Setting email routing for zone %s to %sd", zoneID, action))
+	if _, err := client.Raw(http.MethodPost, emailRoutingEndpoint(zoneID, action), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting email routing for zone %q to %sd: %w", zoneID, action, err))
+	}
+
+	settings := emailRoutingSettings{
+		SkipWizard:    cloudflare.BoolPtr(d.Get("skip_wizard").(bool)),
+		Subaddressing: cloudflare.BoolPtr(d.Get("subaddressing").(bool)),
+	}
+	if _, err := client.Raw(http.MethodPatch, emailRoutingEndpoint(zoneID, ""), settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating email routing settings for zone %q: %w", zoneID, err))
+	}
+
+	if v, ok := d.GetOk("catch_all"); ok {
+		cfg := v.([]interface{})[0].(map[string]interface{})
+		rule := map[string]interface{}{
+			"enabled":  cfg["enabled"].(bool),
+			"matchers": []map[string]interface{}{{"type": "all"}},
+			"actions": []map[string]interface{}{{
+				"type":  cfg["action"].(string),
+				"value": expandInterfaceToStringList(cfg["destinations"]),
+			}},
+		}
+		if _, err := client.Raw(http.MethodPut, emailRoutingEndpoint(zoneID, "rules/catch_all"), rule); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating email routing catch-all rule for zone %q: %w", zoneID, err))
+		}
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareEmailRoutingSettingsRead(ctx, d, meta)
+}
+
+func resourceCloudflareEmailRoutingSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Id()
+
+	res, err := client.Raw(http.MethodGet, emailRoutingEndpoint(zoneID, ""), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading email routing settings for zone %q: %w", zoneID, err))
+	}
+
+	var settings emailRoutingSettings
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding email routing settings for zone %q: %w", zoneID, err))
+	}
+
+	d.Set("zone_id", zoneID)
+	d.Set("name", settings.Name)
+	d.Set("status", settings.Status)
+	d.Set("tag", settings.Tag)
+	if settings.Enabled != nil {
+		d.Set("enabled", *settings.Enabled)
+	}
+	if settings.SkipWizard != nil {
+		d.Set("skip_wizard", *settings.SkipWizard)
+	}
+	if settings.Subaddressing != nil {
+		d.Set("subaddressing", *settings.Subaddressing)
+	}
+
+	res, err = client.Raw(http.MethodGet, emailRoutingEndpoint(zoneID, "rules/catch_all"), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading email routing catch-all rule for zone %q: %w", zoneID, err))
+	}
+
+	var catchAll emailRoutingCatchAll
+	if err := json.Unmarshal(res, &catchAll); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding email routing catch-all rule for zone %q: %w", zoneID, err))
+	}
+
+	if len(catchAll.Actions) > 0 {
+		d.Set("catch_all", []interface{}{map[string]interface{}{
+			"enabled":      catchAll.Enabled != nil && *catchAll.Enabled,
+			"action":       catchAll.Actions[0].Type,
+			"destinations": catchAll.Actions[0].Value,
+		}})
+	}
+
+	return nil
+}
+
+func resourceCloudflareEmailRoutingSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Id()
+
+	tflog.Info(ctx, fmt.Sprintf("Disabling email routing for zone %s", zoneID))
+
+	if _, err := client.Raw(http.MethodPost, emailRoutingEndpoint(zoneID, "disable"), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling email routing for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareEmailRoutingSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareEmailRoutingSettingsRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
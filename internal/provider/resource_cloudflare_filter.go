@@ -28,6 +28,7 @@ func resourceCloudflareFilter() *schema.Resource {
 func resourceCloudflareFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
+	defer lockZoneWrites(client, zoneID)()
 
 	var err error
 
@@ -100,6 +101,7 @@ func resourceCloudflareFilterRead(ctx context.Context, d *schema.ResourceData, m
 func resourceCloudflareFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
+	defer lockZoneWrites(client, zoneID)()
 
 	var newFilter cloudflare.Filter
 	newFilter.ID = d.Id()
@@ -138,6 +140,7 @@ func resourceCloudflareFilterUpdate(ctx context.Context, d *schema.ResourceData,
 func resourceCloudflareFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
+	defer lockZoneWrites(client, zoneID)()
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting Cloudflare Filter: id %s for zone %s", d.Id(), zoneID))
 
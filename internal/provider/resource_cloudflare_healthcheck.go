@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -81,6 +83,12 @@ func resourceCloudflareHealthcheckRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("modified_on", healthcheck.ModifiedOn.Format(time.RFC3339Nano))
 	d.Set("check_regions", healthcheck.CheckRegions)
 
+	windows, err := getHealthcheckSuspensionWindows(client, zoneID, d.Id())
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading suspension windows for healthcheck %q", d.Id())))
+	}
+	d.Set("suspension_window", flattenHealthcheckSuspensionWindows(windows))
+
 	return nil
 }
 
@@ -113,6 +121,13 @@ func resourceCloudflareHealthcheckCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(retry)
 	}
 
+	if windows := expandHealthcheckSuspensionWindows(d); len(windows) > 0 {
+		if err := setHealthcheckSuspensionWindows(client, zoneID, d.Id(), windows); err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error setting suspension windows for healthcheck %q", d.Id())))
+		}
+		return resourceCloudflareHealthcheckRead(ctx, d, meta)
+	}
+
 	return nil
 }
 
@@ -130,6 +145,12 @@ func resourceCloudflareHealthcheckUpdate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating healthcheck")))
 	}
 
+	if d.HasChange("suspension_window") {
+		if err := setHealthcheckSuspensionWindows(client, zoneID, d.Id(), expandHealthcheckSuspensionWindows(d)); err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error setting suspension windows for healthcheck %q", d.Id())))
+		}
+	}
+
 	return resourceCloudflareHealthcheckRead(ctx, d, meta)
 }
 
@@ -258,6 +279,62 @@ func healthcheckSetStruct(d *schema.ResourceData) (cloudflare.Healthcheck, error
 	return healthcheck, nil
 }
 
+// healthcheckSuspensionWindow mirrors the suspension_windows field of the
+// healthcheck API object, which the pinned client does not cover. Windows
+// suppress notifications while probes keep running.
+type healthcheckSuspensionWindow struct {
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+}
+
+func expandHealthcheckSuspensionWindows(d *schema.ResourceData) []healthcheckSuspensionWindow {
+	windows := []healthcheckSuspensionWindow{}
+	for _, rawData := range d.Get("suspension_window").([]interface{}) {
+		data := rawData.(map[string]interface{})
+		windows = append(windows, healthcheckSuspensionWindow{
+			StartTime: data["start_time"].(string),
+			EndTime:   data["end_time"].(string),
+		})
+	}
+	return windows
+}
+
+func flattenHealthcheckSuspensionWindows(windows []healthcheckSuspensionWindow) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(windows))
+	for _, window := range windows {
+		flattened = append(flattened, map[string]interface{}{
+			"start_time": window.StartTime,
+			"end_time":   window.EndTime,
+		})
+	}
+	return flattened
+}
+
+func setHealthcheckSuspensionWindows(client *cloudflare.API, zoneID, healthcheckID string, windows []healthcheckSuspensionWindow) error {
+	payload := struct {
+		SuspensionWindows []healthcheckSuspensionWindow `json:"suspension_windows"`
+	}{SuspensionWindows: windows}
+
+	_, err := client.Raw(http.MethodPatch, fmt.Sprintf("/zones/%s/healthchecks/%s", zoneID, healthcheckID), payload)
+	return err
+}
+
+func getHealthcheckSuspensionWindows(client *cloudflare.API, zoneID, healthcheckID string) ([]healthcheckSuspensionWindow, error) {
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/zones/%s/healthchecks/%s", zoneID, healthcheckID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var healthcheck struct {
+		SuspensionWindows []healthcheckSuspensionWindow `json:"suspension_windows"`
+	}
+	if err := json.Unmarshal(res, &healthcheck); err != nil {
+		return nil, err
+	}
+
+	return healthcheck.SuspensionWindows, nil
+}
+
 func flattenHealthcheckHeader(header map[string][]string) *schema.Set {
 	flattened := make([]interface{}, 0)
 	for k, v := range header {
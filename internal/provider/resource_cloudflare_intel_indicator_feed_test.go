@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareIntelIndicatorFeed(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_intel_indicator_feed.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareIntelIndicatorFeedConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "description", "Acceptance test feed"),
+					resource.TestCheckResourceAttrSet(name, "created_on"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareIntelIndicatorFeedConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_intel_indicator_feed" "%[1]s" {
+		account_id  = "%[2]s"
+		name        = "%[1]s"
+		description = "Acceptance test feed"
+	}`, name, accountID)
+}
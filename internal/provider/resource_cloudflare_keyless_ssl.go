@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// keylessSSLTunnel mirrors the tunnel field of the Keyless SSL API object,
+// which the pinned client does not cover.
+type keylessSSLTunnel struct {
+	VnetID    string `json:"vnet_id"`
+	PrivateIP string `json:"private_ip"`
+}
+
+func resourceCloudflareKeylessSSL() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareKeylessSSLSchema(),
+		CreateContext: resourceCloudflareKeylessSSLCreate,
+		ReadContext:   resourceCloudflareKeylessSSLRead,
+		UpdateContext: resourceCloudflareKeylessSSLUpdate,
+		DeleteContext: resourceCloudflareKeylessSSLDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareKeylessSSLImport,
+		},
+		Description: "Provides a resource which manages a Keyless SSL configuration, keeping the private key on a key server you operate while Cloudflare terminates TLS. Requires the zone to be on an Enterprise plan.",
+	}
+}
+
+func expandKeylessSSLTunnel(d *schema.ResourceData) *keylessSSLTunnel {
+	tunnels := d.Get("tunnel").([]interface{})
+	if len(tunnels) == 0 {
+		return nil
+	}
+	tunnel := tunnels[0].(map[string]interface{})
+	return &keylessSSLTunnel{
+		VnetID:    tunnel["vnet_id"].(string),
+		PrivateIP: tunnel["private_ip"].(string),
+	}
+}
+
+// setKeylessSSLTunnel patches the tunnel configuration, which the pinned
+// client cannot express. A nil tunnel reverts to connecting over the public
+// internet.
+func setKeylessSSLTunnel(client *cloudflare.API, zoneID, keylessSSLID string, tunnel *keylessSSLTunnel) error {
+	payload := struct {
+		Tunnel *keylessSSLTunnel `json:"tunnel"`
+	}{Tunnel: tunnel}
+
+	_, err := client.Raw(http.MethodPatch, fmt.Sprintf("/zones/%s/keyless_certificates/%s", zoneID, keylessSSLID), payload)
+	return err
+}
+
+func resourceCloudflareKeylessSSLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	request := cloudflare.KeylessSSLCreateRequest{
+		Host:         d.Get("host").(string),
+		Port:         d.Get("port").(int),
+		Certificate:  d.Get("certificate").(string),
+		Name:         d.Get("name").(string),
+		BundleMethod: d.Get("bundle_method").(string),
+	}
+
+	keylessSSL, err := client.CreateKeylessSSL(ctx, zoneID, request)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Keyless SSL configuration: %w", err))
+	}
+
+	d.SetId(keylessSSL.ID)
+
+	if tunnel := expandKeylessSSLTunnel(d); tunnel != nil {
+		if err := setKeylessSSLTunnel(client, zoneID, keylessSSL.ID, tunnel); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting tunnel on Keyless SSL configuration %q: %w", keylessSSL.ID, err))
+		}
+	}
+
+	if !d.Get("enabled").(bool) {
+		enabled := false
+		if _, err := client.UpdateKeylessSSL(ctx, zoneID, keylessSSL.ID, cloudflare.KeylessSSLUpdateRequest{Enabled: &enabled}); err != nil {
+			return diag.FromErr(fmt.Errorf("error disabling Keyless SSL configuration %q: %w", keylessSSL.ID, err))
+		}
+	}
+
+	return resourceCloudflareKeylessSSLRead(ctx, d, meta)
+}
+
+func resourceCloudflareKeylessSSLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	// read through the raw endpoint rather than the typed client so the
+	// tunnel configuration is included
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/zones/%s/keyless_certificates/%s", zoneID, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Keyless SSL configuration %q: %w", d.Id(), err))
+	}
+
+	var keylessSSL struct {
+		Name    string            `json:"name"`
+		Host    string            `json:"host"`
+		Port    int               `json:"port"`
+		Status  string            `json:"status"`
+		Enabled bool              `json:"enabled"`
+		Tunnel  *keylessSSLTunnel `json:"tunnel"`
+	}
+	if err := json.Unmarshal(res, &keylessSSL); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Keyless SSL configuration: %w", err))
+	}
+
+	d.Set("name", keylessSSL.Name)
+	d.Set("host", keylessSSL.Host)
+	d.Set("port", keylessSSL.Port)
+	d.Set("status", keylessSSL.Status)
+	d.Set("enabled", keylessSSL.Enabled)
+
+	if keylessSSL.Tunnel != nil {
+		d.Set("tunnel", []map[string]interface{}{{
+			"vnet_id":    keylessSSL.Tunnel.VnetID,
+			"private_ip": keylessSSL.Tunnel.PrivateIP,
+		}})
+	} else {
+		d.Set("tunnel", []map[string]interface{}{})
+	}
+
+	return nil
+}
+
+func resourceCloudflareKeylessSSLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	if d.HasChanges("host", "port", "name", "enabled") {
+		enabled := d.Get("enabled").(bool)
+		request := cloudflare.KeylessSSLUpdateRequest{
+			Host:    d.Get("host").(string),
+			Port:    d.Get("port").(int),
+			Name:    d.Get("name").(string),
+			Enabled: &enabled,
+		}
+
+		if _, err := client.UpdateKeylessSSL(ctx, zoneID, d.Id(), request); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Keyless SSL configuration %q: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tunnel") {
+		if err := setKeylessSSLTunnel(client, zoneID, d.Id(), expandKeylessSSLTunnel(d)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting tunnel on Keyless SSL configuration %q: %w", d.Id(), err))
+		}
+	}
+
+	return resourceCloudflareKeylessSSLRead(ctx, d, meta)
+}
+
+func resourceCloudflareKeylessSSLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	if err := client.DeleteKeylessSSL(ctx, zoneID, d.Id()); err != nil {
+		if !strings.Contains(err.Error(), "HTTP status 404") {
+			return diag.FromErr(fmt.Errorf("error deleting Keyless SSL configuration %q: %w", d.Id(), err))
+		}
+	}
+
+	return nil
+}
+
+func resourceCloudflareKeylessSSLImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id %q specified, should be in format "zoneID/keylessSSLID"`, d.Id())
+	}
+
+	zoneID, keylessSSLID := attributes[0], attributes[1]
+
+	d.Set("zone_id", zoneID)
+	d.SetId(keylessSSLID)
+
+	resourceCloudflareKeylessSSLRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
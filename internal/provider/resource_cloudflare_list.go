@@ -37,7 +37,18 @@ func resourceCloudflareListCreate(ctx context.Context, d *schema.ResourceData, m
 		Kind:        d.Get("kind").(string),
 	})
 	if err != nil {
-		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List %s", d.Get("name").(string))))
+		// The create may have gone through even though the response never
+		// arrived. List names are unique per account, so re-check for the list
+		// by name before failing to stop a retried apply creating a duplicate.
+		if isRequestTimeoutError(err) {
+			if existing, lookupErr := findListByName(ctx, client, accountID, d.Get("name").(string)); lookupErr == nil && existing != nil {
+				tflog.Info(ctx, fmt.Sprintf("create request for List %q timed out but the list exists, adopting %s", d.Get("name").(string), existing.ID))
+				list = *existing
+			}
+		}
+		if list.ID == "" {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List %s", d.Get("name").(string))))
+		}
 	}
 
 	d.SetId(list.ID)
@@ -57,6 +68,24 @@ func resourceCloudflareListCreate(ctx context.Context, d *schema.ResourceData, m
 	return resourceCloudflareListRead(ctx, d, meta)
 }
 
+// findListByName returns the list with the given name, or nil if no such list
+// exists.
+func findListByName(ctx context.Context, client *cloudflare.API, accountID, name string) (*cloudflare.List, error) {
+	lists, err := client.ListLists(ctx, cloudflare.ListListsParams{AccountID: accountID})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, list := range lists {
+		if list.Name == name {
+			list := list
+			return &list, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func resourceCloudflareListImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 2)
 
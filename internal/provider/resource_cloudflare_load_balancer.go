@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -40,6 +42,90 @@ func resourceCloudflareLoadBalancer() *schema.Resource {
 	}
 }
 
+// loadBalancerAdaptiveRouting and loadBalancerLocationStrategy model routing
+// controls the pinned client does not yet know about. Together with the rule
+// override and load balancer wrappers below they are sent through client.Raw
+// so the extra fields reach the API.
+type loadBalancerAdaptiveRouting struct {
+	FailoverAcrossPools *bool `json:"failover_across_pools,omitempty"`
+}
+
+type loadBalancerLocationStrategy struct {
+	PreferECS string `json:"prefer_ecs,omitempty"`
+	Mode      string `json:"mode,omitempty"`
+}
+
+type loadBalancerRuleOverridesModel struct {
+	cloudflare.LoadBalancerRuleOverrides
+	AdaptiveRouting  *loadBalancerAdaptiveRouting  `json:"adaptive_routing,omitempty"`
+	LocationStrategy *loadBalancerLocationStrategy `json:"location_strategy,omitempty"`
+}
+
+type loadBalancerRuleModel struct {
+	cloudflare.LoadBalancerRule
+	Overrides loadBalancerRuleOverridesModel `json:"overrides"`
+}
+
+type loadBalancerModel struct {
+	cloudflare.LoadBalancer
+	AdaptiveRouting  *loadBalancerAdaptiveRouting  `json:"adaptive_routing,omitempty"`
+	LocationStrategy *loadBalancerLocationStrategy `json:"location_strategy,omitempty"`
+	Rules            []*loadBalancerRuleModel      `json:"rules,omitempty"`
+}
+
+func loadBalancerEndpoint(zoneID, loadBalancerID string) string {
+	endpoint := fmt.Sprintf("/zones/%s/load_balancers", zoneID)
+	if loadBalancerID != "" {
+		endpoint += "/" + loadBalancerID
+	}
+	return endpoint
+}
+
+var adaptiveRoutingElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"failover_across_pools": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Extends zero-downtime failover of requests to healthy origins from alternate pools, when no healthy alternate exists in the same pool, determined by the failover order for each pool.",
+		},
+	},
+}
+
+var locationStrategyElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"prefer_ecs": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"", "always", "never", "proximity", "geo"}, false),
+			Description:  "Whether the EDNS Client Subnet (ECS) GeoIP should be preferred as the authoritative location.",
+		},
+
+		"mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"", "pop", "resolver_ip"}, false),
+			Description:  "Determines the authoritative location when ECS is not preferred, does not exist in the request, or its GeoIP lookup is unsuccessful.",
+		},
+	},
+}
+
+var randomSteeringElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"default_weight": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+		},
+
+		"pool_weights": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeFloat,
+			},
+		},
+	},
+}
+
 var rulesElem = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"name": {
@@ -104,7 +190,7 @@ var rulesElem = &schema.Resource{
 					"steering_policy": {
 						Type:         schema.TypeString,
 						Optional:     true,
-						ValidateFunc: validation.StringInSlice([]string{"off", "geo", "dynamic_latency", "random", "proximity", ""}, false),
+						ValidateFunc: validation.StringInSlice([]string{"off", "geo", "dynamic_latency", "random", "proximity", "least_outstanding_requests", "least_connections", ""}, false),
 					},
 
 					"fallback_pool": {
@@ -131,6 +217,33 @@ var rulesElem = &schema.Resource{
 						Optional: true,
 						Elem:     regionPoolElem,
 					},
+
+					"country_pools": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     countryPoolElem,
+					},
+
+					"random_steering": {
+						Type:     schema.TypeList,
+						MaxItems: 1,
+						Optional: true,
+						Elem:     randomSteeringElem,
+					},
+
+					"adaptive_routing": {
+						Type:     schema.TypeList,
+						MaxItems: 1,
+						Optional: true,
+						Elem:     adaptiveRoutingElem,
+					},
+
+					"location_strategy": {
+						Type:     schema.TypeList,
+						MaxItems: 1,
+						Optional: true,
+						Elem:     locationStrategyElem,
+					},
 				},
 			},
 		},
@@ -207,9 +320,29 @@ var regionPoolElem = &schema.Resource{
 	},
 }
 
+var countryPoolElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"country": {
+			Type:     schema.TypeString,
+			Required: true,
+			// let the api handle validating countries
+		},
+
+		"pool_ids": {
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+		},
+	},
+}
+
 var localPoolElems = map[string]*schema.Resource{
-	"pop":    popPoolElem,
-	"region": regionPoolElem,
+	"pop":     popPoolElem,
+	"region":  regionPoolElem,
+	"country": countryPoolElem,
 }
 
 func resourceCloudflareLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -218,7 +351,7 @@ func resourceCloudflareLoadBalancerCreate(ctx context.Context, d *schema.Resourc
 	zoneID := d.Get("zone_id").(string)
 
 	enabled := d.Get("enabled").(bool)
-	newLoadBalancer := cloudflare.LoadBalancer{
+	newLoadBalancer := loadBalancerModel{LoadBalancer: cloudflare.LoadBalancer{
 		Name:           d.Get("name").(string),
 		FallbackPool:   d.Get("fallback_pool_id").(string),
 		DefaultPools:   expandInterfaceToStringList(d.Get("default_pool_ids")),
@@ -227,7 +360,7 @@ func resourceCloudflareLoadBalancerCreate(ctx context.Context, d *schema.Resourc
 		TTL:            d.Get("ttl").(int),
 		SteeringPolicy: d.Get("steering_policy").(string),
 		Persistence:    d.Get("session_affinity").(string),
-	}
+	}}
 
 	if description, ok := d.GetOk("description"); ok {
 		newLoadBalancer.Description = description.(string)
@@ -253,6 +386,14 @@ func resourceCloudflareLoadBalancerCreate(ctx context.Context, d *schema.Resourc
 		newLoadBalancer.PopPools = expandedPopPools
 	}
 
+	if countryPools, ok := d.GetOk("country_pools"); ok {
+		expandedCountryPools, err := expandGeoPools(countryPools, "country")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newLoadBalancer.CountryPools = expandedCountryPools
+	}
+
 	if sessionAffinityTTL, ok := d.GetOk("session_affinity_ttl"); ok {
 		newLoadBalancer.PersistenceTTL = sessionAffinityTTL.(int)
 	}
@@ -273,13 +414,30 @@ func resourceCloudflareLoadBalancerCreate(ctx context.Context, d *schema.Resourc
 		newLoadBalancer.Rules = v
 	}
 
+	if adaptiveRouting, ok := d.GetOk("adaptive_routing"); ok {
+		newLoadBalancer.AdaptiveRouting = expandLoadBalancerAdaptiveRouting(adaptiveRouting)
+	}
+
+	if locationStrategy, ok := d.GetOk("location_strategy"); ok {
+		newLoadBalancer.LocationStrategy = expandLoadBalancerLocationStrategy(locationStrategy)
+	}
+
+	if randomSteering, ok := d.GetOk("random_steering"); ok {
+		newLoadBalancer.RandomSteering = expandLoadBalancerRandomSteering(randomSteering)
+	}
+
 	tflog.Info(ctx, fmt.Sprintf("Creating Cloudflare Load Balancer from struct: %+v", newLoadBalancer))
 
-	r, err := client.CreateLoadBalancer(ctx, zoneID, newLoadBalancer)
+	res, err := client.Raw(http.MethodPost, loadBalancerEndpoint(zoneID, ""), newLoadBalancer)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating load balancer for zone"))
 	}
 
+	var r loadBalancerModel
+	if err := json.Unmarshal(res, &r); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal load balancer: %w", err))
+	}
+
 	if r.ID == "" {
 		return diag.FromErr(fmt.Errorf("failed to find id in Create response; resource was empty"))
 	}
@@ -297,7 +455,7 @@ func resourceCloudflareLoadBalancerUpdate(ctx context.Context, d *schema.Resourc
 	zoneID := d.Get("zone_id").(string)
 
 	enabled := d.Get("enabled").(bool)
-	loadBalancer := cloudflare.LoadBalancer{
+	loadBalancer := loadBalancerModel{LoadBalancer: cloudflare.LoadBalancer{
 		ID:             d.Id(),
 		Name:           d.Get("name").(string),
 		FallbackPool:   d.Get("fallback_pool_id").(string),
@@ -307,7 +465,7 @@ func resourceCloudflareLoadBalancerUpdate(ctx context.Context, d *schema.Resourc
 		TTL:            d.Get("ttl").(int),
 		SteeringPolicy: d.Get("steering_policy").(string),
 		Persistence:    d.Get("session_affinity").(string),
-	}
+	}}
 
 	if description, ok := d.GetOk("description"); ok {
 		loadBalancer.Description = description.(string)
@@ -329,6 +487,14 @@ func resourceCloudflareLoadBalancerUpdate(ctx context.Context, d *schema.Resourc
 		loadBalancer.PopPools = expandedPopPools
 	}
 
+	if countryPools, ok := d.GetOk("country_pools"); ok {
+		expandedCountryPools, err := expandGeoPools(countryPools, "country")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		loadBalancer.CountryPools = expandedCountryPools
+	}
+
 	if sessionAffinityTTL, ok := d.GetOk("session_affinity_ttl"); ok {
 		loadBalancer.PersistenceTTL = sessionAffinityTTL.(int)
 	}
@@ -349,11 +515,22 @@ func resourceCloudflareLoadBalancerUpdate(ctx context.Context, d *schema.Resourc
 		loadBalancer.Rules = v
 	}
 
+	if adaptiveRouting, ok := d.GetOk("adaptive_routing"); ok {
+		loadBalancer.AdaptiveRouting = expandLoadBalancerAdaptiveRouting(adaptiveRouting)
+	}
+
+	if locationStrategy, ok := d.GetOk("location_strategy"); ok {
+		loadBalancer.LocationStrategy = expandLoadBalancerLocationStrategy(locationStrategy)
+	}
+
+	if randomSteering, ok := d.GetOk("random_steering"); ok {
+		loadBalancer.RandomSteering = expandLoadBalancerRandomSteering(randomSteering)
+	}
+
 	tflog.Info(ctx, fmt.Sprintf("Updating Cloudflare Load Balancer from struct: %+v", loadBalancer))
 
-	_, err := client.ModifyLoadBalancer(ctx, zoneID, loadBalancer)
-	if err != nil {
-		return diag.FromErr(errors.Wrap(err, "error creating load balancer for zone"))
+	if _, err := client.Raw(http.MethodPut, loadBalancerEndpoint(zoneID, d.Id()), loadBalancer); err != nil {
+		return diag.FromErr(errors.Wrap(err, "error updating load balancer for zone"))
 	}
 
 	return resourceCloudflareLoadBalancerRead(ctx, d, meta)
@@ -380,7 +557,7 @@ func resourceCloudflareLoadBalancerRead(ctx context.Context, d *schema.ResourceD
 	zoneID := d.Get("zone_id").(string)
 	loadBalancerID := d.Id()
 
-	loadBalancer, err := client.LoadBalancerDetails(ctx, zoneID, loadBalancerID)
+	res, err := client.Raw(http.MethodGet, loadBalancerEndpoint(zoneID, loadBalancerID), nil)
 	if err != nil {
 		if strings.Contains(err.Error(), "HTTP status 404") {
 			tflog.Info(ctx, fmt.Sprintf("Load balancer %s in zone %s not found", loadBalancerID, zoneID))
@@ -391,6 +568,11 @@ func resourceCloudflareLoadBalancerRead(ctx context.Context, d *schema.ResourceD
 			fmt.Sprintf("Error reading load balancer resource from API for resource %s in zone %s", loadBalancerID, zoneID)))
 	}
 
+	var loadBalancer loadBalancerModel
+	if err := json.Unmarshal(res, &loadBalancer); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal load balancer: %w", err))
+	}
+
 	d.Set("name", loadBalancer.Name)
 	d.Set("fallback_pool_id", loadBalancer.FallbackPool)
 	d.Set("proxied", loadBalancer.Proxied)
@@ -409,6 +591,24 @@ func resourceCloudflareLoadBalancerRead(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	if _, ok := d.GetOk("adaptive_routing"); ok && loadBalancer.AdaptiveRouting != nil {
+		if err := d.Set("adaptive_routing", flattenLoadBalancerAdaptiveRouting(loadBalancer.AdaptiveRouting)); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to set adaptive_routing: %w", err))
+		}
+	}
+
+	if _, ok := d.GetOk("location_strategy"); ok && loadBalancer.LocationStrategy != nil {
+		if err := d.Set("location_strategy", flattenLoadBalancerLocationStrategy(loadBalancer.LocationStrategy)); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to set location_strategy: %w", err))
+		}
+	}
+
+	if _, ok := d.GetOk("random_steering"); ok && loadBalancer.RandomSteering != nil {
+		if err := d.Set("random_steering", flattenLoadBalancerRandomSteering(loadBalancer.RandomSteering)); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to set random_steering: %w", err))
+		}
+	}
+
 	if len(loadBalancer.Rules) > 0 {
 		fr, err := flattenRules(d, loadBalancer.Rules)
 		if err != nil {
@@ -431,6 +631,10 @@ func resourceCloudflareLoadBalancerRead(ctx context.Context, d *schema.ResourceD
 		tflog.Warn(ctx, fmt.Sprintf("Error setting region_pools on load balancer %q: %s", d.Id(), err))
 	}
 
+	if err := d.Set("country_pools", flattenGeoPools(loadBalancer.CountryPools, "country")); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error setting country_pools on load balancer %q: %s", d.Id(), err))
+	}
+
 	if loadBalancer.PersistenceTTL != 0 {
 		d.Set("session_affinity_ttl", loadBalancer.PersistenceTTL)
 	}
@@ -438,6 +642,75 @@ func resourceCloudflareLoadBalancerRead(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
+func expandLoadBalancerAdaptiveRouting(data interface{}) *loadBalancerAdaptiveRouting {
+	cfg := data.([]interface{})
+	if len(cfg) == 0 {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+	failoverAcrossPools := m["failover_across_pools"].(bool)
+	return &loadBalancerAdaptiveRouting{FailoverAcrossPools: &failoverAcrossPools}
+}
+
+func flattenLoadBalancerAdaptiveRouting(adaptiveRouting *loadBalancerAdaptiveRouting) []interface{} {
+	m := map[string]interface{}{}
+	if adaptiveRouting.FailoverAcrossPools != nil {
+		m["failover_across_pools"] = *adaptiveRouting.FailoverAcrossPools
+	}
+	return []interface{}{m}
+}
+
+func expandLoadBalancerLocationStrategy(data interface{}) *loadBalancerLocationStrategy {
+	cfg := data.([]interface{})
+	if len(cfg) == 0 {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+	return &loadBalancerLocationStrategy{
+		PreferECS: m["prefer_ecs"].(string),
+		Mode:      m["mode"].(string),
+	}
+}
+
+func flattenLoadBalancerLocationStrategy(locationStrategy *loadBalancerLocationStrategy) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"prefer_ecs": locationStrategy.PreferECS,
+		"mode":       locationStrategy.Mode,
+	}}
+}
+
+func expandLoadBalancerRandomSteering(data interface{}) *cloudflare.RandomSteering {
+	cfg := data.([]interface{})
+	if len(cfg) == 0 {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+
+	randomSteering := &cloudflare.RandomSteering{
+		DefaultWeight: m["default_weight"].(float64),
+	}
+
+	poolWeights := make(map[string]float64)
+	for pool, weight := range m["pool_weights"].(map[string]interface{}) {
+		poolWeights[pool] = weight.(float64)
+	}
+	if len(poolWeights) > 0 {
+		randomSteering.PoolWeights = poolWeights
+	}
+
+	return randomSteering
+}
+
+func flattenLoadBalancerRandomSteering(randomSteering *cloudflare.RandomSteering) []interface{} {
+	m := map[string]interface{}{
+		"default_weight": randomSteering.DefaultWeight,
+	}
+	if len(randomSteering.PoolWeights) > 0 {
+		m["pool_weights"] = randomSteering.PoolWeights
+	}
+	return []interface{}{m}
+}
+
 func flattenGeoPools(pools map[string][]string, geoType string) *schema.Set {
 	flattened := make([]interface{}, 0)
 	for k, v := range pools {
@@ -452,9 +725,10 @@ func flattenGeoPools(pools map[string][]string, geoType string) *schema.Set {
 
 func flattenSessionAffinityAttrs(attrs *cloudflare.SessionAffinityAttributes) map[string]interface{} {
 	return map[string]interface{}{
-		"drain_duration": strconv.Itoa(attrs.DrainDuration),
-		"samesite":       attrs.SameSite,
-		"secure":         attrs.Secure,
+		"drain_duration":         strconv.Itoa(attrs.DrainDuration),
+		"samesite":               attrs.SameSite,
+		"secure":                 attrs.Secure,
+		"zero_downtime_failover": attrs.ZeroDowntimeFailover,
 	}
 }
 
@@ -493,7 +767,7 @@ func resourceCloudflareLoadBalancerImport(ctx context.Context, d *schema.Resourc
 	return []*schema.ResourceData{d}, nil
 }
 
-func flattenRules(d *schema.ResourceData, rules []*cloudflare.LoadBalancerRule) (interface{}, error) {
+func flattenRules(d *schema.ResourceData, rules []*loadBalancerRuleModel) (interface{}, error) {
 	if len(rules) == 0 {
 		return nil, nil
 	}
@@ -542,7 +816,7 @@ func flattenRules(d *schema.ResourceData, rules []*cloudflare.LoadBalancerRule)
 				m["overrides"] = []interface{}{om}
 			}
 			if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.session_affinity_ttl", idx)); ok {
-				om["session_affinity"] = o.PersistenceTTL
+				om["session_affinity_ttl"] = o.PersistenceTTL
 				m["overrides"] = []interface{}{om}
 			}
 			if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.ttl", idx)); ok {
@@ -569,6 +843,29 @@ func flattenRules(d *schema.ResourceData, rules []*cloudflare.LoadBalancerRule)
 				om["region_pools"] = flattenGeoPools(o.RegionPools, "region")
 				m["overrides"] = []interface{}{om}
 			}
+			if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.country_pools", idx)); ok {
+				om["country_pools"] = flattenGeoPools(o.CountryPools, "country")
+				m["overrides"] = []interface{}{om}
+			}
+			if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.random_steering", idx)); o.RandomSteering != nil && ok {
+				rs := map[string]interface{}{}
+				if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.random_steering.0.default_weight", idx)); ok {
+					rs["default_weight"] = o.RandomSteering.DefaultWeight
+				}
+				if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.random_steering.0.pool_weights", idx)); ok {
+					rs["pool_weights"] = o.RandomSteering.PoolWeights
+				}
+				om["random_steering"] = []interface{}{rs}
+				m["overrides"] = []interface{}{om}
+			}
+			if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.adaptive_routing", idx)); o.AdaptiveRouting != nil && ok {
+				om["adaptive_routing"] = flattenLoadBalancerAdaptiveRouting(o.AdaptiveRouting)
+				m["overrides"] = []interface{}{om}
+			}
+			if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.location_strategy", idx)); o.LocationStrategy != nil && ok {
+				om["location_strategy"] = flattenLoadBalancerLocationStrategy(o.LocationStrategy)
+				m["overrides"] = []interface{}{om}
+			}
 			if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.session_affinity_attributes", idx)); o.SessionAffinityAttrs != nil && ok {
 				saa := map[string]interface{}{}
 				om["session_affinity_attributes"] = saa
@@ -579,6 +876,9 @@ func flattenRules(d *schema.ResourceData, rules []*cloudflare.LoadBalancerRule)
 				if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.session_affinity_attributes.secure", idx)); ok {
 					saa["secure"] = o.SessionAffinityAttrs.Secure
 				}
+				if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.session_affinity_attributes.zero_downtime_failover", idx)); ok {
+					saa["zero_downtime_failover"] = o.SessionAffinityAttrs.ZeroDowntimeFailover
+				}
 			}
 		}
 
@@ -587,13 +887,13 @@ func flattenRules(d *schema.ResourceData, rules []*cloudflare.LoadBalancerRule)
 	return cfResources, nil
 }
 
-func expandRules(rdata interface{}) ([]*cloudflare.LoadBalancerRule, error) {
-	var rules []*cloudflare.LoadBalancerRule
+func expandRules(rdata interface{}) ([]*loadBalancerRuleModel, error) {
+	var rules []*loadBalancerRuleModel
 	for _, ele := range rdata.([]interface{}) {
 		r := ele.(map[string]interface{})
-		lbr := &cloudflare.LoadBalancerRule{
+		lbr := &loadBalancerRuleModel{LoadBalancerRule: cloudflare.LoadBalancerRule{
 			Name: r["name"].(string),
-		}
+		}}
 		if v, ok := r["priority"]; ok {
 			lbr.Priority = v.(int)
 		}
@@ -635,6 +935,10 @@ func expandRules(rdata interface{}) ([]*cloudflare.LoadBalancerRule, error) {
 					v.Secure = sec.(string)
 					lbr.Overrides.SessionAffinityAttrs = v
 				}
+				if zdf, ok := attr["zero_downtime_failover"]; ok {
+					v.ZeroDowntimeFailover = zdf.(string)
+					lbr.Overrides.SessionAffinityAttrs = v
+				}
 			}
 
 			if ttl, ok := ov["ttl"]; ok {
@@ -668,6 +972,41 @@ func expandRules(rdata interface{}) ([]*cloudflare.LoadBalancerRule, error) {
 				}
 				lbr.Overrides.RegionPools = expandedRegionPools
 			}
+
+			if cp, ok := ov["country_pools"]; ok {
+				expandedCountryPools, err := expandGeoPools(cp, "country")
+				if err != nil {
+					return nil, err
+				}
+				lbr.Overrides.CountryPools = expandedCountryPools
+			}
+
+			if rs, ok := ov["random_steering"]; ok && len(rs.([]interface{})) > 0 {
+				steering := rs.([]interface{})[0].(map[string]interface{})
+				v := &cloudflare.RandomSteering{}
+				if dw, ok := steering["default_weight"]; ok {
+					v.DefaultWeight = dw.(float64)
+					lbr.Overrides.RandomSteering = v
+				}
+				if pw, ok := steering["pool_weights"]; ok {
+					poolWeights := make(map[string]float64)
+					for pool, weight := range pw.(map[string]interface{}) {
+						poolWeights[pool] = weight.(float64)
+					}
+					if len(poolWeights) > 0 {
+						v.PoolWeights = poolWeights
+						lbr.Overrides.RandomSteering = v
+					}
+				}
+			}
+
+			if ar, ok := ov["adaptive_routing"]; ok && len(ar.([]interface{})) > 0 {
+				lbr.Overrides.AdaptiveRouting = expandLoadBalancerAdaptiveRouting(ar)
+			}
+
+			if ls, ok := ov["location_strategy"]; ok && len(ls.([]interface{})) > 0 {
+				lbr.Overrides.LocationStrategy = expandLoadBalancerLocationStrategy(ls)
+			}
 		}
 
 		for _, fixedResponseData := range r["fixed_response"].([]interface{}) {
@@ -713,6 +1052,14 @@ func expandSessionAffinityAttrs(attrs interface{}) (*cloudflare.SessionAffinityA
 			if cfSessionAffinityAttrs.DrainDuration, err = strconv.Atoi(v.(string)); err != nil {
 				return nil, err
 			}
+		case "zero_downtime_failover":
+			zeroDowntimeFailover := v.(string)
+			switch zeroDowntimeFailover {
+			case "none", "temporary", "sticky":
+				cfSessionAffinityAttrs.ZeroDowntimeFailover = zeroDowntimeFailover
+			default:
+				return nil, fmt.Errorf("zero_downtime_failover must be one of [none, temporary, sticky], got %q", zeroDowntimeFailover)
+			}
 		}
 	}
 
@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"time"
@@ -27,6 +29,26 @@ func resourceCloudflareLoadBalancerMonitor() *schema.Resource {
 	}
 }
 
+// loadBalancerMonitorModel extends the pinned client's monitor type with the
+// consecutive threshold fields it does not yet model. The requests go through
+// client.Raw so the extra fields reach the API.
+type loadBalancerMonitorModel struct {
+	cloudflare.LoadBalancerMonitor
+	ConsecutiveUp   int `json:"consecutive_up,omitempty"`
+	ConsecutiveDown int `json:"consecutive_down,omitempty"`
+}
+
+func loadBalancerMonitorEndpoint(client *cloudflare.API, monitorID string) string {
+	endpoint := "/user/load_balancers/monitors"
+	if client.AccountID != "" {
+		endpoint = fmt.Sprintf("/accounts/%s/load_balancers/monitors", client.AccountID)
+	}
+	if monitorID != "" {
+		endpoint += "/" + monitorID
+	}
+	return endpoint
+}
+
 func resourceCloudflareLoadBalancerPoolMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
@@ -85,21 +107,40 @@ func resourceCloudflareLoadBalancerPoolMonitorCreate(ctx context.Context, d *sch
 		} else {
 			loadBalancerMonitor.Path = "/"
 		}
-
-		if probeZone, ok := d.GetOk("probe_zone"); ok {
-			loadBalancerMonitor.ProbeZone = probeZone.(string)
-		} else {
-			loadBalancerMonitor.ProbeZone = ""
+	case "smtp":
+		if expectedCodes, ok := d.GetOk("expected_codes"); ok {
+			loadBalancerMonitor.ExpectedCodes = expectedCodes.(string)
 		}
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Load Balancer Monitor from struct: %+v", loadBalancerMonitor))
+	if probeZone, ok := d.GetOk("probe_zone"); ok {
+		loadBalancerMonitor.ProbeZone = probeZone.(string)
+	} else {
+		loadBalancerMonitor.ProbeZone = ""
+	}
+
+	monitor := loadBalancerMonitorModel{LoadBalancerMonitor: loadBalancerMonitor}
 
-	r, err := client.CreateLoadBalancerMonitor(ctx, loadBalancerMonitor)
+	if consecutiveUp, ok := d.GetOk("consecutive_up"); ok {
+		monitor.ConsecutiveUp = consecutiveUp.(int)
+	}
+
+	if consecutiveDown, ok := d.GetOk("consecutive_down"); ok {
+		monitor.ConsecutiveDown = consecutiveDown.(int)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Load Balancer Monitor from struct: %+v", monitor))
+
+	res, err := client.Raw(http.MethodPost, loadBalancerMonitorEndpoint(client, ""), monitor)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating load balancer monitor"))
 	}
 
+	var r loadBalancerMonitorModel
+	if err := json.Unmarshal(res, &r); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal load balancer monitor: %w", err))
+	}
+
 	if r.ID == "" {
 		return diag.FromErr(fmt.Errorf("failed to find id in create response; resource was empty"))
 	}
@@ -173,18 +214,31 @@ func resourceCloudflareLoadBalancerPoolMonitorUpdate(ctx context.Context, d *sch
 		} else {
 			loadBalancerMonitor.Path = "/"
 		}
-
-		if probeZone, ok := d.GetOk("probe_zone"); ok {
-			loadBalancerMonitor.ProbeZone = probeZone.(string)
-		} else {
-			loadBalancerMonitor.ProbeZone = ""
+	case "smtp":
+		if expectedCodes, ok := d.GetOk("expected_codes"); ok {
+			loadBalancerMonitor.ExpectedCodes = expectedCodes.(string)
 		}
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Update Cloudflare Load Balancer Monitor from struct: %+v", loadBalancerMonitor))
+	if probeZone, ok := d.GetOk("probe_zone"); ok {
+		loadBalancerMonitor.ProbeZone = probeZone.(string)
+	} else {
+		loadBalancerMonitor.ProbeZone = ""
+	}
+
+	monitor := loadBalancerMonitorModel{LoadBalancerMonitor: loadBalancerMonitor}
 
-	_, err := client.ModifyLoadBalancerMonitor(ctx, loadBalancerMonitor)
-	if err != nil {
+	if consecutiveUp, ok := d.GetOk("consecutive_up"); ok {
+		monitor.ConsecutiveUp = consecutiveUp.(int)
+	}
+
+	if consecutiveDown, ok := d.GetOk("consecutive_down"); ok {
+		monitor.ConsecutiveDown = consecutiveDown.(int)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update Cloudflare Load Balancer Monitor from struct: %+v", monitor))
+
+	if _, err := client.Raw(http.MethodPut, loadBalancerMonitorEndpoint(client, d.Id()), monitor); err != nil {
 		return diag.FromErr(errors.Wrap(err, "error modifying load balancer monitor"))
 	}
 
@@ -206,7 +260,7 @@ func expandLoadBalancerMonitorHeader(cfgSet interface{}) map[string][]string {
 func resourceCloudflareLoadBalancerPoolMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	loadBalancerMonitor, err := client.LoadBalancerMonitorDetails(ctx, d.Id())
+	res, err := client.Raw(http.MethodGet, loadBalancerMonitorEndpoint(client, d.Id()), nil)
 	if err != nil {
 		if strings.Contains(err.Error(), "HTTP status 404") {
 			tflog.Info(ctx, fmt.Sprintf("Load balancer monitor %s no longer exists", d.Id()))
@@ -217,21 +271,32 @@ func resourceCloudflareLoadBalancerPoolMonitorRead(ctx context.Context, d *schem
 				fmt.Sprintf("Error reading load balancer monitor from API for resource %s ", d.Id())))
 		}
 	}
+
+	var loadBalancerMonitor loadBalancerMonitorModel
+	if err := json.Unmarshal(res, &loadBalancerMonitor); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal load balancer monitor: %w", err))
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Read Cloudflare Load Balancer Monitor from API as struct: %+v", loadBalancerMonitor))
 
 	if loadBalancerMonitor.Type == "http" || loadBalancerMonitor.Type == "https" {
 		d.Set("allow_insecure", loadBalancerMonitor.AllowInsecure)
 		d.Set("expected_body", loadBalancerMonitor.ExpectedBody)
-		d.Set("expected_codes", loadBalancerMonitor.ExpectedCodes)
 		d.Set("follow_redirects", loadBalancerMonitor.FollowRedirects)
 		d.Set("path", loadBalancerMonitor.Path)
-		d.Set("probe_zone", loadBalancerMonitor.ProbeZone)
 
 		if err := d.Set("header", flattenLoadBalancerMonitorHeader(loadBalancerMonitor.Header)); err != nil {
 			tflog.Warn(ctx, fmt.Sprintf("Error setting header for load balancer monitor %q: %s", d.Id(), err))
 		}
 	}
 
+	if loadBalancerMonitor.Type == "http" || loadBalancerMonitor.Type == "https" || loadBalancerMonitor.Type == "smtp" {
+		d.Set("expected_codes", loadBalancerMonitor.ExpectedCodes)
+	}
+
+	d.Set("consecutive_up", loadBalancerMonitor.ConsecutiveUp)
+	d.Set("consecutive_down", loadBalancerMonitor.ConsecutiveDown)
+	d.Set("probe_zone", loadBalancerMonitor.ProbeZone)
 	d.Set("description", loadBalancerMonitor.Description)
 	d.Set("interval", loadBalancerMonitor.Interval)
 	d.Set("method", loadBalancerMonitor.Method)
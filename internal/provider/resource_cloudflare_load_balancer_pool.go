@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
+	"net/http"
 	"strings"
 
 	"time"
@@ -28,14 +30,43 @@ func resourceCloudflareLoadBalancerPool() *schema.Resource {
 	}
 }
 
+// loadBalancerPoolOrigin extends the pinned client's origin representation
+// with the port override and virtual network fields it does not yet model.
+type loadBalancerPoolOrigin struct {
+	cloudflare.LoadBalancerOrigin
+	Port             uint16 `json:"port,omitempty"`
+	VirtualNetworkID string `json:"virtual_network_id,omitempty"`
+}
+
+// loadBalancerPoolModel wraps the pinned client's pool representation so
+// origins carry the extended fields. Requests are sent through client.Raw for
+// the same reason.
+type loadBalancerPoolModel struct {
+	cloudflare.LoadBalancerPool
+	Origins []loadBalancerPoolOrigin `json:"origins"`
+}
+
+func loadBalancerPoolEndpoint(client *cloudflare.API, poolID string) string {
+	endpoint := "/user/load_balancers/pools"
+	if client.AccountID != "" {
+		endpoint = fmt.Sprintf("/accounts/%s/load_balancers/pools", client.AccountID)
+	}
+	if poolID != "" {
+		endpoint += "/" + poolID
+	}
+	return endpoint
+}
+
 func resourceCloudflareLoadBalancerPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	loadBalancerPool := cloudflare.LoadBalancerPool{
-		Name:           d.Get("name").(string),
-		Origins:        expandLoadBalancerOrigins(d.Get("origins").(*schema.Set)),
-		Enabled:        d.Get("enabled").(bool),
-		MinimumOrigins: d.Get("minimum_origins").(int),
+	loadBalancerPool := loadBalancerPoolModel{
+		LoadBalancerPool: cloudflare.LoadBalancerPool{
+			Name:           d.Get("name").(string),
+			Enabled:        d.Get("enabled").(bool),
+			MinimumOrigins: d.Get("minimum_origins").(int),
+		},
+		Origins: expandLoadBalancerOrigins(d.Get("origins").(*schema.Set)),
 	}
 
 	if lat, ok := d.GetOk("latitude"); ok {
@@ -73,11 +104,16 @@ func resourceCloudflareLoadBalancerPoolCreate(ctx context.Context, d *schema.Res
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Load Balancer Pool from struct: %+v", loadBalancerPool))
 
-	r, err := client.CreateLoadBalancerPool(ctx, loadBalancerPool)
+	res, err := client.Raw(http.MethodPost, loadBalancerPoolEndpoint(client, ""), loadBalancerPool)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating load balancer pool"))
 	}
 
+	var r loadBalancerPoolModel
+	if err := json.Unmarshal(res, &r); err != nil {
+		return diag.FromErr(errors.Wrap(err, "error decoding load balancer pool"))
+	}
+
 	if r.ID == "" {
 		return diag.FromErr(fmt.Errorf("cailed to find id in create response; resource was empty"))
 	}
@@ -92,12 +128,14 @@ func resourceCloudflareLoadBalancerPoolCreate(ctx context.Context, d *schema.Res
 func resourceCloudflareLoadBalancerPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	loadBalancerPool := cloudflare.LoadBalancerPool{
-		ID:             d.Id(),
-		Name:           d.Get("name").(string),
-		Origins:        expandLoadBalancerOrigins(d.Get("origins").(*schema.Set)),
-		Enabled:        d.Get("enabled").(bool),
-		MinimumOrigins: d.Get("minimum_origins").(int),
+	loadBalancerPool := loadBalancerPoolModel{
+		LoadBalancerPool: cloudflare.LoadBalancerPool{
+			ID:             d.Id(),
+			Name:           d.Get("name").(string),
+			Enabled:        d.Get("enabled").(bool),
+			MinimumOrigins: d.Get("minimum_origins").(int),
+		},
+		Origins: expandLoadBalancerOrigins(d.Get("origins").(*schema.Set)),
 	}
 
 	if lat, ok := d.GetOk("latitude"); ok {
@@ -135,8 +173,7 @@ func resourceCloudflareLoadBalancerPoolUpdate(ctx context.Context, d *schema.Res
 
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Load Balancer Pool from struct: %+v", loadBalancerPool))
 
-	_, err := client.ModifyLoadBalancerPool(ctx, loadBalancerPool)
-	if err != nil {
+	if _, err := client.Raw(http.MethodPut, loadBalancerPoolEndpoint(client, d.Id()), loadBalancerPool); err != nil {
 		return diag.FromErr(errors.Wrap(err, "error updating load balancer pool"))
 	}
 
@@ -194,14 +231,18 @@ func expandLoadBalancerOriginSteering(s *schema.Set) *cloudflare.LoadBalancerOri
 	return nil
 }
 
-func expandLoadBalancerOrigins(originSet *schema.Set) (origins []cloudflare.LoadBalancerOrigin) {
+func expandLoadBalancerOrigins(originSet *schema.Set) (origins []loadBalancerPoolOrigin) {
 	for _, iface := range originSet.List() {
 		o := iface.(map[string]interface{})
-		origin := cloudflare.LoadBalancerOrigin{
-			Name:    o["name"].(string),
-			Address: o["address"].(string),
-			Enabled: o["enabled"].(bool),
-			Weight:  o["weight"].(float64),
+		origin := loadBalancerPoolOrigin{
+			LoadBalancerOrigin: cloudflare.LoadBalancerOrigin{
+				Name:    o["name"].(string),
+				Address: o["address"].(string),
+				Enabled: o["enabled"].(bool),
+				Weight:  o["weight"].(float64),
+			},
+			Port:             uint16(o["port"].(int)),
+			VirtualNetworkID: o["virtual_network_id"].(string),
 		}
 
 		if header, ok := o["header"]; ok {
@@ -216,7 +257,7 @@ func expandLoadBalancerOrigins(originSet *schema.Set) (origins []cloudflare.Load
 func resourceCloudflareLoadBalancerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	loadBalancerPool, err := client.LoadBalancerPoolDetails(ctx, d.Id())
+	res, err := client.Raw(http.MethodGet, loadBalancerPoolEndpoint(client, d.Id()), nil)
 	if err != nil {
 		if strings.Contains(err.Error(), "HTTP status 404") {
 			tflog.Info(ctx, fmt.Sprintf("Load balancer pool %s no longer exists", d.Id()))
@@ -227,6 +268,12 @@ func resourceCloudflareLoadBalancerPoolRead(ctx context.Context, d *schema.Resou
 				fmt.Sprintf("Error reading load balancer pool from API for resource %s ", d.Id())))
 		}
 	}
+
+	var loadBalancerPool loadBalancerPoolModel
+	if err := json.Unmarshal(res, &loadBalancerPool); err != nil {
+		return diag.FromErr(errors.Wrap(err,
+			fmt.Sprintf("Error decoding load balancer pool from API for resource %s ", d.Id())))
+	}
 	tflog.Debug(ctx, fmt.Sprintf("Read Cloudflare Load Balancer Pool from API as struct: %+v", loadBalancerPool))
 
 	d.Set("name", loadBalancerPool.Name)
@@ -287,15 +334,17 @@ func flattenLoadBalancerOriginSteering(os *cloudflare.LoadBalancerOriginSteering
 	}})
 }
 
-func flattenLoadBalancerOrigins(d *schema.ResourceData, origins []cloudflare.LoadBalancerOrigin) *schema.Set {
+func flattenLoadBalancerOrigins(d *schema.ResourceData, origins []loadBalancerPoolOrigin) *schema.Set {
 	flattened := make([]interface{}, 0)
 	for _, o := range origins {
 		cfg := map[string]interface{}{
-			"name":    o.Name,
-			"address": o.Address,
-			"enabled": o.Enabled,
-			"weight":  o.Weight,
-			"header":  flattenLoadBalancerPoolHeader(o.Header),
+			"name":               o.Name,
+			"address":            o.Address,
+			"enabled":            o.Enabled,
+			"weight":             o.Weight,
+			"header":             flattenLoadBalancerPoolHeader(o.Header),
+			"port":               int(o.Port),
+			"virtual_network_id": o.VirtualNetworkID,
 		}
 
 		flattened = append(flattened, cfg)
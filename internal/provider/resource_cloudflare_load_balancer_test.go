@@ -239,6 +239,8 @@ func TestAccCloudflareLoadBalancer_Rules(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "rules.#", "3"),
 					resource.TestCheckResourceAttr(name, "rules.1.fixed_response.0.message_body", "hello"),
 					resource.TestCheckResourceAttr(name, "rules.2.overrides.0.region_pools.#", "1"),
+					resource.TestCheckResourceAttr(name, "rules.2.overrides.0.country_pools.#", "1"),
+					resource.TestCheckResourceAttr(name, "rules.2.overrides.0.random_steering.0.default_weight", "0.2"),
 				),
 			},
 		},
@@ -580,6 +582,13 @@ resource "cloudflare_load_balancer" "%[3]s" {
 		    region = "ENAM"
 		    pool_ids = ["${cloudflare_load_balancer_pool.%[3]s.id}"]
 	    }
+      country_pools {
+		    country = "US"
+		    pool_ids = ["${cloudflare_load_balancer_pool.%[3]s.id}"]
+	    }
+      random_steering {
+        default_weight = 0.2
+      }
     }
   }
 }`, zoneID, zone, id)
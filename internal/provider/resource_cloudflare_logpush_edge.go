@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// logpushEdgeJob represents an Instant Logs session as returned by the
+// logpush/edge endpoints, which the pinned client does not cover.
+type logpushEdgeJob struct {
+	SessionID       string `json:"session_id,omitempty"`
+	Kind            string `json:"kind,omitempty"`
+	Fields          string `json:"fields,omitempty"`
+	Sample          int    `json:"sample,omitempty"`
+	Filter          string `json:"filter,omitempty"`
+	DestinationConf string `json:"destination_conf,omitempty"`
+}
+
+func resourceCloudflareLogpushEdge() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareLogpushEdgeSchema(),
+		CreateContext: resourceCloudflareLogpushEdgeCreate,
+		ReadContext:   resourceCloudflareLogpushEdgeRead,
+		DeleteContext: resourceCloudflareLogpushEdgeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareLogpushEdgeImport,
+		},
+		Description: "Provides a resource which manages Cloudflare Instant Logs sessions, exposing a websocket URL (`destination_conf`) that live HTTP request logs can be streamed from. Sessions end on their own once no client is connected to the websocket; destroying the resource only removes it from state.",
+	}
+}
+
+func resourceCloudflareLogpushEdgeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	job := logpushEdgeJob{
+		Kind:   "instant-logs",
+		Fields: d.Get("fields").(string),
+		Sample: d.Get("sample").(int),
+		Filter: d.Get("filter").(string),
+	}
+
+	res, err := client.Raw("POST", fmt.Sprintf("/zones/%s/logpush/edge/jobs", zoneID), job)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Instant Logs session for zone %q: %w", zoneID, err))
+	}
+
+	var created logpushEdgeJob
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Instant Logs session: %w", err))
+	}
+
+	d.SetId(created.SessionID)
+
+	return resourceCloudflareLogpushEdgeRead(ctx, d, meta)
+}
+
+func resourceCloudflareLogpushEdgeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := client.Raw("GET", fmt.Sprintf("/zones/%s/logpush/edge", zoneID), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Instant Logs sessions for zone %q: %w", zoneID, err))
+	}
+
+	var jobs []logpushEdgeJob
+	if err := json.Unmarshal(res, &jobs); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Instant Logs sessions: %w", err))
+	}
+
+	for _, job := range jobs {
+		if job.SessionID != d.Id() {
+			continue
+		}
+
+		d.Set("fields", job.Fields)
+		d.Set("sample", job.Sample)
+		d.Set("filter", job.Filter)
+		d.Set("session_id", job.SessionID)
+		d.Set("destination_conf", job.DestinationConf)
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Instant Logs session %s no longer exists", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudflareLogpushEdgeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The API has no delete endpoint for Instant Logs sessions; they end on
+	// their own shortly after the last websocket client disconnects.
+	tflog.Info(ctx, fmt.Sprintf("removing Instant Logs session %s from state; the session ends once no client is connected", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudflareLogpushEdgeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/sessionID\"", d.Id())
+	}
+
+	zoneID, sessionID := attributes[0], attributes[1]
+	d.Set("zone_id", zoneID)
+	d.SetId(sessionID)
+
+	resourceCloudflareLogpushEdgeRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
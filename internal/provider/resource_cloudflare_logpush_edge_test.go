@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareLogpushEdge(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_logpush_edge.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareLogpushEdgeConfig(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "fields", "ClientIP,ClientRequestHost,EdgeResponseStatus"),
+					resource.TestCheckResourceAttr(name, "sample", "100"),
+					resource.TestCheckResourceAttrSet(name, "session_id"),
+					resource.TestCheckResourceAttrSet(name, "destination_conf"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareLogpushEdgeConfig(name, zoneID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_logpush_edge" "%[1]s" {
+		zone_id = "%[2]s"
+		fields  = "ClientIP,ClientRequestHost,EdgeResponseStatus"
+		sample  = 100
+	}`, name, zoneID)
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type magicWANLANStaticAddressing struct {
+	Address          string `json:"address,omitempty"`
+	VirtualAddress   string `json:"virtual_address,omitempty"`
+	SecondaryAddress string `json:"secondary_address,omitempty"`
+}
+
+type magicWANLAN struct {
+	ID               string                       `json:"id,omitempty"`
+	SiteID           string                       `json:"site_id,omitempty"`
+	Name             string                       `json:"name,omitempty"`
+	Physport         int                          `json:"physport,omitempty"`
+	VlanTag          int                          `json:"vlan_tag,omitempty"`
+	HaLink           *bool                        `json:"ha_link,omitempty"`
+	StaticAddressing *magicWANLANStaticAddressing `json:"static_addressing,omitempty"`
+}
+
+type magicWANLANResponse struct {
+	LAN magicWANLAN `json:"lan"`
+}
+
+func resourceCloudflareMagicWANLAN() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMagicWANLANSchema(),
+		CreateContext: resourceCloudflareMagicWANLANCreate,
+		ReadContext:   resourceCloudflareMagicWANLANRead,
+		UpdateContext: resourceCloudflareMagicWANLANUpdate,
+		DeleteContext: resourceCloudflareMagicWANLANDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMagicWANLANImport,
+		},
+		Description: "Provides a Cloudflare Magic WAN LAN resource. LANs describe the local networks attached to a Magic WAN site's connector ports.",
+	}
+}
+
+func magicWANLANEndpoint(accountID, siteID, lanID string) string {
+	endpoint := fmt.Sprintf("/accounts/%s/magic/sites/%s/lans", accountID, siteID)
+	if lanID != "" {
+		endpoint = fmt.Sprintf("%s/%s", endpoint, lanID)
+	}
+	return endpoint
+}
+
+func magicWANLANFromResource(d *schema.ResourceData) magicWANLAN {
+	haLink := d.Get("ha_link").(bool)
+	lan := magicWANLAN{
+		Name:     d.Get("name").(string),
+		Physport: d.Get("physport").(int),
+		VlanTag:  d.Get("vlan_tag").(int),
+		HaLink:   &haLink,
+	}
+
+	if staticAddressing, ok := d.GetOk("static_addressing"); ok {
+		cfg := staticAddressing.([]interface{})[0].(map[string]interface{})
+		lan.StaticAddressing = &magicWANLANStaticAddressing{
+			Address:          cfg["address"].(string),
+			VirtualAddress:   cfg["virtual_address"].(string),
+			SecondaryAddress: cfg["secondary_address"].(string),
+		}
+	}
+
+	return lan
+}
+
+func resourceCloudflareMagicWANLANCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	siteID := d.Get("site_id").(string)
+
+	res, err := client.Raw(http.MethodPost, magicWANLANEndpoint(accountID, siteID, ""), magicWANLANFromResource(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create Magic WAN LAN: %w", err))
+	}
+
+	// LAN creation returns the full set of LANs on the site; single LAN
+	// operations return the LAN keyed by `lan`.
+	var response struct {
+		LANs []magicWANLAN `json:"lans"`
+	}
+	if err := json.Unmarshal(res, &response); err == nil && len(response.LANs) > 0 {
+		d.SetId(response.LANs[len(response.LANs)-1].ID)
+	} else {
+		var single magicWANLANResponse
+		if err := json.Unmarshal(res, &single); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to decode Magic WAN LAN create response: %w", err))
+		}
+		d.SetId(single.LAN.ID)
+	}
+
+	return resourceCloudflareMagicWANLANRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicWANLANRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	siteID := d.Get("site_id").(string)
+
+	res, err := client.Raw(http.MethodGet, magicWANLANEndpoint(accountID, siteID, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			tflog.Info(ctx, fmt.Sprintf("Magic WAN LAN %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to fetch Magic WAN LAN: %w", err))
+	}
+
+	var response magicWANLANResponse
+	if err := json.Unmarshal(res, &response); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to decode Magic WAN LAN: %w", err))
+	}
+
+	lan := response.LAN
+	d.Set("name", lan.Name)
+	d.Set("physport", lan.Physport)
+	d.Set("vlan_tag", lan.VlanTag)
+	if lan.HaLink != nil {
+		d.Set("ha_link", *lan.HaLink)
+	}
+	if lan.StaticAddressing != nil {
+		d.Set("static_addressing", []interface{}{map[string]interface{}{
+			"address":           lan.StaticAddressing.Address,
+			"virtual_address":   lan.StaticAddressing.VirtualAddress,
+			"secondary_address": lan.StaticAddressing.SecondaryAddress,
+		}})
+	}
+
+	return nil
+}
+
+func resourceCloudflareMagicWANLANUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	siteID := d.Get("site_id").(string)
+
+	lan := magicWANLANFromResource(d)
+	lan.HaLink = nil
+
+	_, err := client.Raw(http.MethodPut, magicWANLANEndpoint(accountID, siteID, d.Id()), lan)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update Magic WAN LAN: %w", err))
+	}
+
+	return resourceCloudflareMagicWANLANRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicWANLANDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	siteID := d.Get("site_id").(string)
+
+	_, err := client.Raw(http.MethodDelete, magicWANLANEndpoint(accountID, siteID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete Magic WAN LAN: %w", err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareMagicWANLANImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/siteID/lanID\"", d.Id())
+	}
+
+	accountID, siteID, lanID := attributes[0], attributes[1], attributes[2]
+
+	d.Set("account_id", accountID)
+	d.Set("site_id", siteID)
+	d.SetId(lanID)
+
+	resourceCloudflareMagicWANLANRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareMagicWANLAN_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_magic_wan_lan.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMagicWANLANConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "physport", "1"),
+					resource.TestCheckResourceAttr(name, "vlan_tag", "42"),
+					resource.TestCheckResourceAttr(name, "static_addressing.0.address", "192.0.2.1/24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareMagicWANLANConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_magic_wan_site" "%[1]s" {
+  account_id  = "%[2]s"
+  name        = "%[1]s"
+  description = "test site"
+
+  location {
+    lat = "40.71"
+    lon = "-74.00"
+  }
+}
+
+resource "cloudflare_magic_wan_lan" "%[1]s" {
+  account_id = "%[2]s"
+  site_id    = cloudflare_magic_wan_site.%[1]s.id
+  name       = "%[1]s"
+  physport   = 1
+  vlan_tag   = 42
+
+  static_addressing {
+    address = "192.0.2.1/24"
+  }
+}`, rnd, accountID)
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type magicWANSiteLocation struct {
+	Lat string `json:"lat,omitempty"`
+	Lon string `json:"lon,omitempty"`
+}
+
+type magicWANSite struct {
+	ID                   string                `json:"id,omitempty"`
+	Name                 string                `json:"name,omitempty"`
+	Description          string                `json:"description,omitempty"`
+	ConnectorID          string                `json:"connector_id,omitempty"`
+	SecondaryConnectorID string                `json:"secondary_connector_id,omitempty"`
+	HaMode               *bool                 `json:"ha_mode,omitempty"`
+	Location             *magicWANSiteLocation `json:"location,omitempty"`
+}
+
+type magicWANSiteResponse struct {
+	Site magicWANSite `json:"site"`
+}
+
+func resourceCloudflareMagicWANSite() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMagicWANSiteSchema(),
+		CreateContext: resourceCloudflareMagicWANSiteCreate,
+		ReadContext:   resourceCloudflareMagicWANSiteRead,
+		UpdateContext: resourceCloudflareMagicWANSiteUpdate,
+		DeleteContext: resourceCloudflareMagicWANSiteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMagicWANSiteImport,
+		},
+		Description: "Provides a Cloudflare Magic WAN site resource. Sites represent branch locations and are associated with Magic WAN Connectors for automated onboarding.",
+	}
+}
+
+func magicWANSiteEndpoint(accountID, siteID string) string {
+	endpoint := fmt.Sprintf("/accounts/%s/magic/sites", accountID)
+	if siteID != "" {
+		endpoint = fmt.Sprintf("%s/%s", endpoint, siteID)
+	}
+	return endpoint
+}
+
+func magicWANSiteFromResource(d *schema.ResourceData) magicWANSite {
+	haMode := d.Get("ha_mode").(bool)
+	site := magicWANSite{
+		Name:                 d.Get("name").(string),
+		Description:          d.Get("description").(string),
+		ConnectorID:          d.Get("connector_id").(string),
+		SecondaryConnectorID: d.Get("secondary_connector_id").(string),
+		HaMode:               &haMode,
+	}
+
+	if location, ok := d.GetOk("location"); ok {
+		locationCfg := location.([]interface{})[0].(map[string]interface{})
+		site.Location = &magicWANSiteLocation{
+			Lat: locationCfg["lat"].(string),
+			Lon: locationCfg["lon"].(string),
+		}
+	}
+
+	return site
+}
+
+func resourceCloudflareMagicWANSiteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodPost, magicWANSiteEndpoint(accountID, ""), magicWANSiteFromResource(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create Magic WAN site: %w", err))
+	}
+
+	var response magicWANSiteResponse
+	if err := json.Unmarshal(res, &response); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to decode Magic WAN site create response: %w", err))
+	}
+
+	d.SetId(response.Site.ID)
+
+	return resourceCloudflareMagicWANSiteRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicWANSiteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodGet, magicWANSiteEndpoint(accountID, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			tflog.Info(ctx, fmt.Sprintf("Magic WAN site %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to fetch Magic WAN site: %w", err))
+	}
+
+	var response magicWANSiteResponse
+	if err := json.Unmarshal(res, &response); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to decode Magic WAN site: %w", err))
+	}
+
+	site := response.Site
+	d.Set("name", site.Name)
+	d.Set("description", site.Description)
+	d.Set("connector_id", site.ConnectorID)
+	d.Set("secondary_connector_id", site.SecondaryConnectorID)
+	if site.HaMode != nil {
+		d.Set("ha_mode", *site.HaMode)
+	}
+	if site.Location != nil {
+		d.Set("location", []interface{}{map[string]interface{}{
+			"lat": site.Location.Lat,
+			"lon": site.Location.Lon,
+		}})
+	}
+
+	return nil
+}
+
+func resourceCloudflareMagicWANSiteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	site := magicWANSiteFromResource(d)
+	site.HaMode = nil
+
+	_, err := client.Raw(http.MethodPut, magicWANSiteEndpoint(accountID, d.Id()), site)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update Magic WAN site: %w", err))
+	}
+
+	return resourceCloudflareMagicWANSiteRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicWANSiteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	_, err := client.Raw(http.MethodDelete, magicWANSiteEndpoint(accountID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete Magic WAN site: %w", err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareMagicWANSiteImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/siteID\"", d.Id())
+	}
+
+	accountID, siteID := attributes[0], attributes[1]
+
+	d.Set("account_id", accountID)
+	d.SetId(siteID)
+
+	resourceCloudflareMagicWANSiteRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareMagicWANSite_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_magic_wan_site.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMagicWANSiteConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "description", "test site"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareMagicWANSiteConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_magic_wan_site" "%[1]s" {
+  account_id  = "%[2]s"
+  name        = "%[1]s"
+  description = "test site"
+
+  location {
+    lat = "40.71"
+    lon = "-74.00"
+  }
+}`, rnd, accountID)
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type magicWANWANStaticAddressing struct {
+	Address          string `json:"address,omitempty"`
+	GatewayAddress   string `json:"gateway_address,omitempty"`
+	SecondaryAddress string `json:"secondary_address,omitempty"`
+}
+
+type magicWANWAN struct {
+	ID               string                       `json:"id,omitempty"`
+	SiteID           string                       `json:"site_id,omitempty"`
+	Name             string                       `json:"name,omitempty"`
+	Physport         int                          `json:"physport,omitempty"`
+	VlanTag          int                          `json:"vlan_tag,omitempty"`
+	Priority         int                          `json:"priority,omitempty"`
+	StaticAddressing *magicWANWANStaticAddressing `json:"static_addressing,omitempty"`
+}
+
+type magicWANWANResponse struct {
+	WAN magicWANWAN `json:"wan"`
+}
+
+func resourceCloudflareMagicWANWAN() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMagicWANWANSchema(),
+		CreateContext: resourceCloudflareMagicWANWANCreate,
+		ReadContext:   resourceCloudflareMagicWANWANRead,
+		UpdateContext: resourceCloudflareMagicWANWANUpdate,
+		DeleteContext: resourceCloudflareMagicWANWANDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMagicWANWANImport,
+		},
+		Description: "Provides a Cloudflare Magic WAN WAN resource. WANs describe the internet uplinks attached to a Magic WAN site's connector ports.",
+	}
+}
+
+func magicWANWANEndpoint(accountID, siteID, wanID string) string {
+	endpoint := fmt.Sprintf("/accounts/%s/magic/sites/%s/wans", accountID, siteID)
+	if wanID != "" {
+		endpoint = fmt.Sprintf("%s/%s", endpoint, wanID)
+	}
+	return endpoint
+}
+
+func magicWANWANFromResource(d *schema.ResourceData) magicWANWAN {
+	wan := magicWANWAN{
+		Name:     d.Get("name").(string),
+		Physport: d.Get("physport").(int),
+		VlanTag:  d.Get("vlan_tag").(int),
+		Priority: d.Get("priority").(int),
+	}
+
+	if staticAddressing, ok := d.GetOk("static_addressing"); ok {
+		cfg := staticAddressing.([]interface{})[0].(map[string]interface{})
+		wan.StaticAddressing = &magicWANWANStaticAddressing{
+			Address:          cfg["address"].(string),
+			GatewayAddress:   cfg["gateway_address"].(string),
+			SecondaryAddress: cfg["secondary_address"].(string),
+		}
+	}
+
+	return wan
+}
+
+func resourceCloudflareMagicWANWANCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	siteID := d.Get("site_id").(string)
+
+	res, err := client.Raw(http.MethodPost, magicWANWANEndpoint(accountID, siteID, ""), magicWANWANFromResource(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create Magic WAN WAN: %w", err))
+	}
+
+	// WAN creation returns the full set of WANs on the site; single WAN
+	// operations return the WAN keyed by `wan`.
+	var response struct {
+		WANs []magicWANWAN `json:"wans"`
+	}
+	if err := json.Unmarshal(res, &response); err == nil && len(response.WANs) > 0 {
+		d.SetId(response.WANs[len(response.WANs)-1].ID)
+	} else {
+		var single magicWANWANResponse
+		if err := json.Unmarshal(res, &single); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to decode Magic WAN WAN create response: %w", err))
+		}
+		d.SetId(single.WAN.ID)
+	}
+
+	return resourceCloudflareMagicWANWANRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicWANWANRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	siteID := d.Get("site_id").(string)
+
+	res, err := client.Raw(http.MethodGet, magicWANWANEndpoint(accountID, siteID, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			tflog.Info(ctx, fmt.Sprintf("Magic WAN WAN %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to fetch Magic WAN WAN: %w", err))
+	}
+
+	var response magicWANWANResponse
+	if err := json.Unmarshal(res, &response); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to decode Magic WAN WAN: %w", err))
+	}
+
+	wan := response.WAN
+	d.Set("name", wan.Name)
+	d.Set("physport", wan.Physport)
+	d.Set("vlan_tag", wan.VlanTag)
+	d.Set("priority", wan.Priority)
+	if wan.StaticAddressing != nil {
+		d.Set("static_addressing", []interface{}{map[string]interface{}{
+			"address":           wan.StaticAddressing.Address,
+			"gateway_address":   wan.StaticAddressing.GatewayAddress,
+			"secondary_address": wan.StaticAddressing.SecondaryAddress,
+		}})
+	}
+
+	return nil
+}
+
+func resourceCloudflareMagicWANWANUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	siteID := d.Get("site_id").(string)
+
+	_, err := client.Raw(http.MethodPut, magicWANWANEndpoint(accountID, siteID, d.Id()), magicWANWANFromResource(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update Magic WAN WAN: %w", err))
+	}
+
+	return resourceCloudflareMagicWANWANRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicWANWANDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	siteID := d.Get("site_id").(string)
+
+	_, err := client.Raw(http.MethodDelete, magicWANWANEndpoint(accountID, siteID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete Magic WAN WAN: %w", err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareMagicWANWANImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/siteID/wanID\"", d.Id())
+	}
+
+	accountID, siteID, wanID := attributes[0], attributes[1], attributes[2]
+
+	d.Set("account_id", accountID)
+	d.Set("site_id", siteID)
+	d.SetId(wanID)
+
+	resourceCloudflareMagicWANWANRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
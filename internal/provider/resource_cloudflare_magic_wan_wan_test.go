@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareMagicWANWAN_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_magic_wan_wan.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMagicWANWANConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "physport", "2"),
+					resource.TestCheckResourceAttr(name, "priority", "1"),
+					resource.TestCheckResourceAttr(name, "static_addressing.0.address", "203.0.113.2/24"),
+					resource.TestCheckResourceAttr(name, "static_addressing.0.gateway_address", "203.0.113.1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareMagicWANWANConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_magic_wan_site" "%[1]s" {
+  account_id  = "%[2]s"
+  name        = "%[1]s"
+  description = "test site"
+
+  location {
+    lat = "40.71"
+    lon = "-74.00"
+  }
+}
+
+resource "cloudflare_magic_wan_wan" "%[1]s" {
+  account_id = "%[2]s"
+  site_id    = cloudflare_magic_wan_site.%[1]s.id
+  name       = "%[1]s"
+  physport   = 2
+  priority   = 1
+
+  static_addressing {
+    address         = "203.0.113.2/24"
+    gateway_address = "203.0.113.1"
+  }
+}`, rnd, accountID)
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mtlsCertificate is the API representation of an account-level mTLS
+// certificate or CA bundle, which the pinned client does not cover.
+type mtlsCertificate struct {
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	CA           bool   `json:"ca"`
+	Certificates string `json:"certificates,omitempty"`
+	PrivateKey   string `json:"private_key,omitempty"`
+	Issuer       string `json:"issuer,omitempty"`
+	SerialNumber string `json:"serial_number,omitempty"`
+	Signature    string `json:"signature,omitempty"`
+	UploadedOn   string `json:"uploaded_on,omitempty"`
+	ExpiresOn    string `json:"expires_on,omitempty"`
+}
+
+func resourceCloudflareMTLSCertificate() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMTLSCertificateSchema(),
+		CreateContext: resourceCloudflareMTLSCertificateCreate,
+		ReadContext:   resourceCloudflareMTLSCertificateRead,
+		DeleteContext: resourceCloudflareMTLSCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMTLSCertificateImport,
+		},
+		Description: "Provides a resource which uploads an account-level mTLS certificate or CA bundle, used by API Shield mTLS and Gateway. Certificates are immutable; association to hostnames is managed separately.",
+	}
+}
+
+func mtlsCertificateEndpoint(accountID, certificateID string) string {
+	endpoint := fmt.Sprintf("/accounts/%s/mtls_certificates", accountID)
+	if certificateID != "" {
+		endpoint = fmt.Sprintf("%s/%s", endpoint, certificateID)
+	}
+	return endpoint
+}
+
+func resourceCloudflareMTLSCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	certificate := mtlsCertificate{
+		Name:         d.Get("name").(string),
+		CA:           d.Get("ca").(bool),
+		Certificates: d.Get("certificates").(string),
+		PrivateKey:   d.Get("private_key").(string),
+	}
+
+	res, err := client.Raw(http.MethodPost, mtlsCertificateEndpoint(accountID, ""), certificate)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error uploading mTLS certificate: %w", err))
+	}
+
+	if err := json.Unmarshal(res, &certificate); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling mTLS certificate: %w", err))
+	}
+
+	d.SetId(certificate.ID)
+
+	return resourceCloudflareMTLSCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareMTLSCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodGet, mtlsCertificateEndpoint(accountID, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	var certificate mtlsCertificate
+	if err := json.Unmarshal(res, &certificate); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling mTLS certificate: %w", err))
+	}
+
+	d.Set("name", certificate.Name)
+	d.Set("ca", certificate.CA)
+	d.Set("certificates", certificate.Certificates)
+	d.Set("issuer", certificate.Issuer)
+	d.Set("serial_number", certificate.SerialNumber)
+	d.Set("signature", certificate.Signature)
+	d.Set("uploaded_on", certificate.UploadedOn)
+	d.Set("expires_on", certificate.ExpiresOn)
+
+	return nil
+}
+
+func resourceCloudflareMTLSCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	_, err := client.Raw(http.MethodDelete, mtlsCertificateEndpoint(accountID, d.Id()), nil)
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error deleting mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMTLSCertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id %q specified, should be in format "accountID/certificateID"`, d.Id())
+	}
+
+	accountID, certificateID := attributes[0], attributes[1]
+
+	d.Set("account_id", accountID)
+	d.SetId(certificateID)
+
+	resourceCloudflareMTLSCertificateRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
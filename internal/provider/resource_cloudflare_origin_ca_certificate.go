@@ -84,6 +84,18 @@ func resourceCloudflareOriginCACertificateRead(ctx context.Context, d *schema.Re
 		return nil
 	}
 
+	// Removing the certificate from state inside the renewal window makes the
+	// next plan re-issue it, so long-lived certificates are renewed instead of
+	// silently expiring.
+	if earlyRenewalDays := d.Get("early_renewal_days").(int); earlyRenewalDays > 0 {
+		renewalDate := cert.ExpiresOn.Add(time.Duration(-earlyRenewalDays) * 24 * time.Hour)
+		if time.Now().After(renewalDate) {
+			tflog.Info(ctx, fmt.Sprintf("OriginCACertificate %s is within %d days of expiry, marking for re-issuance", certID, earlyRenewalDays))
+			d.SetId("")
+			return nil
+		}
+	}
+
 	hostnames := schema.NewSet(schema.HashString, []interface{}{})
 	for _, h := range cert.Hostnames {
 		hostnames.Add(h)
@@ -38,6 +38,7 @@ func suppressEquivalentURLs(k, old, new string, d *schema.ResourceData) bool {
 func resourceCloudflarePageRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
+	defer lockZoneWrites(client, zoneID)()
 
 	newPageRuleTargets := []cloudflare.PageRuleTarget{
 		{
@@ -147,6 +148,7 @@ func resourceCloudflarePageRuleRead(ctx context.Context, d *schema.ResourceData,
 func resourceCloudflarePageRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
+	defer lockZoneWrites(client, zoneID)()
 
 	updatePageRule := cloudflare.PageRule{}
 
@@ -204,6 +206,7 @@ func resourceCloudflarePageRuleUpdate(ctx context.Context, d *schema.ResourceDat
 func resourceCloudflarePageRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
+	defer lockZoneWrites(client, zoneID)()
 
 	log.Printf("[INFO] Deleting Cloudflare Page Rule: %s, %s", zoneID, d.Id())
 
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflarePagesDomain() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflarePagesDomainSchema(),
+		CreateContext: resourceCloudflarePagesDomainCreate,
+		ReadContext:   resourceCloudflarePagesDomainRead,
+		// wait_for_active only changes apply behaviour; updating it is a no-op
+		UpdateContext: resourceCloudflarePagesDomainRead,
+		DeleteContext: resourceCloudflarePagesDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflarePagesDomainImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Description: "Provides a resource which attaches a custom domain to a Cloudflare Pages project and waits for the domain to become active.",
+	}
+}
+
+func resourceCloudflarePagesDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	projectName := d.Get("project_name").(string)
+	domainName := d.Get("domain").(string)
+
+	params := cloudflare.PagesDomainParameters{
+		AccountID:   accountID,
+		ProjectName: projectName,
+		DomainName:  domainName,
+	}
+
+	_, err := client.PagesAddDomain(ctx, params)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error attaching domain %q to Pages project %q: %w", domainName, projectName, err))
+	}
+
+	d.SetId(domainName)
+
+	if d.Get("wait_for_active").(bool) {
+		retry := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			domain, err := client.GetPagesDomain(ctx, params)
+			if err != nil {
+				return resource.NonRetryableError(fmt.Errorf("error reading Pages domain %q: %w", domainName, err))
+			}
+
+			if domain.Status != "active" {
+				// retrying the validation nudges the API to re-check the DNS
+				// records backing the domain
+				if _, err := client.PagesPatchDomain(ctx, params); err != nil {
+					return resource.NonRetryableError(fmt.Errorf("error retrying validation of Pages domain %q: %w", domainName, err))
+				}
+				return resource.RetryableError(fmt.Errorf("waiting for Pages domain %q to become active, currently %q", domainName, domain.Status))
+			}
+
+			return nil
+		})
+
+		if retry != nil {
+			return diag.FromErr(retry)
+		}
+	}
+
+	return resourceCloudflarePagesDomainRead(ctx, d, meta)
+}
+
+func resourceCloudflarePagesDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	projectName := d.Get("project_name").(string)
+
+	domain, err := client.GetPagesDomain(ctx, cloudflare.PagesDomainParameters{
+		AccountID:   accountID,
+		ProjectName: projectName,
+		DomainName:  d.Id(),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Pages domain %q: %w", d.Id(), err))
+	}
+
+	d.Set("domain", domain.Name)
+	d.Set("status", domain.Status)
+	d.Set("verification_status", domain.VerificationData.Status)
+	d.Set("validation_status", domain.ValidationData.Status)
+	d.Set("validation_method", domain.ValidationData.Method)
+	d.Set("zone_tag", domain.ZoneTag)
+
+	return nil
+}
+
+func resourceCloudflarePagesDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	projectName := d.Get("project_name").(string)
+
+	err := client.PagesDeleteDomain(ctx, cloudflare.PagesDomainParameters{
+		AccountID:   accountID,
+		ProjectName: projectName,
+		DomainName:  d.Id(),
+	})
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error detaching domain %q from Pages project %q: %w", d.Id(), projectName, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflarePagesDomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/projectName/domain\"", d.Id())
+	}
+
+	accountID, projectName, domainName := attributes[0], attributes[1], attributes[2]
+	d.Set("account_id", accountID)
+	d.Set("project_name", projectName)
+	d.Set("wait_for_active", true)
+	d.SetId(domainName)
+
+	resourceCloudflarePagesDomainRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflarePagesDomain(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_pages_domain.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckDomain(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflarePagesDomainConfig(rnd, accountID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "domain", fmt.Sprintf("%s.%s", rnd, domain)),
+					resource.TestCheckResourceAttr(name, "project_name", rnd),
+					resource.TestCheckResourceAttrSet(name, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflarePagesDomainConfig(name, accountID, domain string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_pages_project" "%[1]s" {
+		account_id        = "%[2]s"
+		name              = "%[1]s"
+		production_branch = "main"
+	}
+
+	resource "cloudflare_pages_domain" "%[1]s" {
+		account_id   = "%[2]s"
+		project_name = cloudflare_pages_project.%[1]s.name
+		domain       = "%[1]s.%[3]s"
+	}`, name, accountID, domain)
+}
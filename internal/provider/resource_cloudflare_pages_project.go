@@ -0,0 +1,459 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// pagesProject and friends are the API representation of a Pages project.
+// The pinned client only models environment variables in the deployment
+// configs, so the full payload (secrets, bindings, compatibility settings
+// and placement) is marshalled locally.
+type pagesProject struct {
+	Name              string                         `json:"name,omitempty"`
+	ProductionBranch  string                         `json:"production_branch,omitempty"`
+	SubDomain         string                         `json:"subdomain,omitempty"`
+	Domains           []string                       `json:"domains,omitempty"`
+	CreatedOn         string                         `json:"created_on,omitempty"`
+	Source            *pagesProjectSource            `json:"source,omitempty"`
+	BuildConfig       *pagesProjectBuildConfig       `json:"build_config,omitempty"`
+	DeploymentConfigs *pagesProjectDeploymentConfigs `json:"deployment_configs,omitempty"`
+}
+
+type pagesProjectSource struct {
+	Type   string                    `json:"type,omitempty"`
+	Config *pagesProjectSourceConfig `json:"config,omitempty"`
+}
+
+type pagesProjectSourceConfig struct {
+	Owner                    string   `json:"owner,omitempty"`
+	RepoName                 string   `json:"repo_name,omitempty"`
+	ProductionBranch         string   `json:"production_branch,omitempty"`
+	PRCommentsEnabled        bool     `json:"pr_comments_enabled"`
+	DeploymentsEnabled       bool     `json:"deployments_enabled"`
+	PreviewDeploymentSetting string   `json:"preview_deployment_setting,omitempty"`
+	PreviewBranchIncludes    []string `json:"preview_branch_includes,omitempty"`
+	PreviewBranchExcludes    []string `json:"preview_branch_excludes,omitempty"`
+}
+
+type pagesProjectBuildConfig struct {
+	BuildCommand      string `json:"build_command"`
+	DestinationDir    string `json:"destination_dir"`
+	RootDir           string `json:"root_dir"`
+	WebAnalyticsTag   string `json:"web_analytics_tag,omitempty"`
+	WebAnalyticsToken string `json:"web_analytics_token,omitempty"`
+}
+
+type pagesProjectDeploymentConfigs struct {
+	Preview    *pagesProjectDeploymentConfig `json:"preview,omitempty"`
+	Production *pagesProjectDeploymentConfig `json:"production,omitempty"`
+}
+
+type pagesProjectEnvVar struct {
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value"`
+}
+
+type pagesProjectDeploymentConfig struct {
+	EnvVars                 map[string]pagesProjectEnvVar `json:"env_vars"`
+	KVNamespaces            map[string]map[string]string  `json:"kv_namespaces,omitempty"`
+	R2Buckets               map[string]map[string]string  `json:"r2_buckets,omitempty"`
+	D1Databases             map[string]map[string]string  `json:"d1_databases,omitempty"`
+	DurableObjectNamespaces map[string]map[string]string  `json:"durable_object_namespaces,omitempty"`
+	CompatibilityDate       string                        `json:"compatibility_date,omitempty"`
+	CompatibilityFlags      []string                      `json:"compatibility_flags,omitempty"`
+	Placement               map[string]string             `json:"placement,omitempty"`
+}
+
+func resourceCloudflarePagesProject() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflarePagesProjectSchema(),
+		CreateContext: resourceCloudflarePagesProjectCreate,
+		ReadContext:   resourceCloudflarePagesProjectRead,
+		UpdateContext: resourceCloudflarePagesProjectUpdate,
+		DeleteContext: resourceCloudflarePagesProjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflarePagesProjectImport,
+		},
+		Description: "Provides a resource which manages a Cloudflare Pages project, including its source repository connection, build configuration and per-environment deployment configuration.",
+	}
+}
+
+func pagesProjectEndpoint(accountID, projectName string) string {
+	if projectName == "" {
+		return fmt.Sprintf("/accounts/%s/pages/projects", accountID)
+	}
+	return fmt.Sprintf("/accounts/%s/pages/projects/%s", accountID, projectName)
+}
+
+func expandPagesProjectBinding(raw interface{}, key string) map[string]map[string]string {
+	rawMap := raw.(map[string]interface{})
+	if len(rawMap) == 0 {
+		return nil
+	}
+	bindings := make(map[string]map[string]string, len(rawMap))
+	for name, value := range rawMap {
+		bindings[name] = map[string]string{key: value.(string)}
+	}
+	return bindings
+}
+
+func flattenPagesProjectBinding(bindings map[string]map[string]string, key string) map[string]interface{} {
+	flattened := make(map[string]interface{}, len(bindings))
+	for name, binding := range bindings {
+		flattened[name] = binding[key]
+	}
+	return flattened
+}
+
+func expandPagesProjectDeploymentConfig(raw []interface{}) *pagesProjectDeploymentConfig {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	cfg := raw[0].(map[string]interface{})
+
+	config := &pagesProjectDeploymentConfig{
+		EnvVars:                 map[string]pagesProjectEnvVar{},
+		KVNamespaces:            expandPagesProjectBinding(cfg["kv_namespaces"], "namespace_id"),
+		R2Buckets:               expandPagesProjectBinding(cfg["r2_buckets"], "name"),
+		D1Databases:             expandPagesProjectBinding(cfg["d1_databases"], "id"),
+		DurableObjectNamespaces: expandPagesProjectBinding(cfg["durable_object_namespaces"], "namespace_id"),
+		CompatibilityDate:       cfg["compatibility_date"].(string),
+		CompatibilityFlags:      expandInterfaceToStringList(cfg["compatibility_flags"]),
+	}
+
+	for name, value := range cfg["environment_variables"].(map[string]interface{}) {
+		config.EnvVars[name] = pagesProjectEnvVar{Type: "plain_text", Value: value.(string)}
+	}
+	for name, value := range cfg["secrets"].(map[string]interface{}) {
+		config.EnvVars[name] = pagesProjectEnvVar{Type: "secret_text", Value: value.(string)}
+	}
+
+	if placement := cfg["placement"].([]interface{}); len(placement) > 0 && placement[0] != nil {
+		config.Placement = map[string]string{"mode": placement[0].(map[string]interface{})["mode"].(string)}
+	}
+
+	return config
+}
+
+// flattenPagesProjectDeploymentConfig rebuilds a deployment config block from
+// the API response. Secret values are redacted in API responses, so the
+// configured secrets map is carried through unchanged.
+func flattenPagesProjectDeploymentConfig(config *pagesProjectDeploymentConfig, secrets map[string]interface{}) []interface{} {
+	if config == nil {
+		return nil
+	}
+
+	envVars := map[string]interface{}{}
+	for name, envVar := range config.EnvVars {
+		if envVar.Type == "secret_text" {
+			continue
+		}
+		envVars[name] = envVar.Value
+	}
+
+	flattened := map[string]interface{}{
+		"environment_variables":     envVars,
+		"secrets":                   secrets,
+		"kv_namespaces":             flattenPagesProjectBinding(config.KVNamespaces, "namespace_id"),
+		"r2_buckets":                flattenPagesProjectBinding(config.R2Buckets, "name"),
+		"d1_databases":              flattenPagesProjectBinding(config.D1Databases, "id"),
+		"durable_object_namespaces": flattenPagesProjectBinding(config.DurableObjectNamespaces, "namespace_id"),
+		"compatibility_date":        config.CompatibilityDate,
+		"compatibility_flags":       config.CompatibilityFlags,
+	}
+
+	if mode, ok := config.Placement["mode"]; ok && mode != "" {
+		flattened["placement"] = []interface{}{map[string]interface{}{"mode": mode}}
+	}
+
+	return []interface{}{flattened}
+}
+
+func pagesProjectFromResource(d *schema.ResourceData) pagesProject {
+	project := pagesProject{
+		Name:             d.Get("name").(string),
+		ProductionBranch: d.Get("production_branch").(string),
+	}
+
+	if source, ok := d.GetOk("source"); ok {
+		rawSource := source.([]interface{})[0].(map[string]interface{})
+		project.Source = &pagesProjectSource{Type: rawSource["type"].(string)}
+
+		if rawConfig := rawSource["config"].([]interface{}); len(rawConfig) > 0 && rawConfig[0] != nil {
+			cfg := rawConfig[0].(map[string]interface{})
+			project.Source.Config = &pagesProjectSourceConfig{
+				Owner:                    cfg["owner"].(string),
+				RepoName:                 cfg["repo_name"].(string),
+				ProductionBranch:         cfg["production_branch"].(string),
+				PRCommentsEnabled:        cfg["pr_comments_enabled"].(bool),
+				DeploymentsEnabled:       cfg["deployments_enabled"].(bool),
+				PreviewDeploymentSetting: cfg["preview_deployment_setting"].(string),
+				PreviewBranchIncludes:    expandInterfaceToStringList(cfg["preview_branch_includes"]),
+				PreviewBranchExcludes:    expandInterfaceToStringList(cfg["preview_branch_excludes"]),
+			}
+		}
+	}
+
+	if buildConfig, ok := d.GetOk("build_config"); ok {
+		cfg := buildConfig.([]interface{})[0].(map[string]interface{})
+		project.BuildConfig = &pagesProjectBuildConfig{
+			BuildCommand:      cfg["build_command"].(string),
+			DestinationDir:    cfg["destination_dir"].(string),
+			RootDir:           cfg["root_dir"].(string),
+			WebAnalyticsTag:   cfg["web_analytics_tag"].(string),
+			WebAnalyticsToken: cfg["web_analytics_token"].(string),
+		}
+	}
+
+	if deploymentConfigs, ok := d.GetOk("deployment_configs"); ok {
+		cfg := deploymentConfigs.([]interface{})[0].(map[string]interface{})
+		project.DeploymentConfigs = &pagesProjectDeploymentConfigs{
+			Preview:    expandPagesProjectDeploymentConfig(cfg["preview"].([]interface{})),
+			Production: expandPagesProjectDeploymentConfig(cfg["production"].([]interface{})),
+		}
+	}
+
+	return project
+}
+
+// pagesProjectPreviewAccessDomain returns the wildcard domain an Access
+// application must cover to protect the project's preview deployments.
+func pagesProjectPreviewAccessDomain(subdomain string) string {
+	return fmt.Sprintf("*.%s", subdomain)
+}
+
+// findPagesProjectPreviewAccessApplication looks up the Access application
+// protecting the project's preview deployments, returning nil if none exists.
+func findPagesProjectPreviewAccessApplication(ctx context.Context, client *cloudflare.API, accountID, subdomain string) (*cloudflare.AccessApplication, error) {
+	domain := pagesProjectPreviewAccessDomain(subdomain)
+
+	pageOpts := cloudflare.PaginationOptions{}
+	for {
+		apps, resultInfo, err := client.AccessApplications(ctx, accountID, pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range apps {
+			if apps[i].Domain == domain {
+				return &apps[i], nil
+			}
+		}
+		if resultInfo.Page >= resultInfo.TotalPages {
+			return nil, nil
+		}
+		pageOpts.Page = resultInfo.Page + 1
+	}
+}
+
+// syncPagesProjectPreviewAccess creates or removes the Access application
+// protecting the project's preview deployments to match enable_preview_access.
+func syncPagesProjectPreviewAccess(ctx context.Context, client *cloudflare.API, d *schema.ResourceData, subdomain string) error {
+	accountID := d.Get("account_id").(string)
+
+	app, err := findPagesProjectPreviewAccessApplication(ctx, client, accountID, subdomain)
+	if err != nil {
+		return fmt.Errorf("error looking up preview Access application of Pages project %q: %w", d.Id(), err)
+	}
+
+	if d.Get("enable_preview_access").(bool) {
+		if app == nil {
+			created, err := client.CreateAccessApplication(ctx, accountID, cloudflare.AccessApplication{
+				Name:            fmt.Sprintf("Pages preview deployments: %s", d.Get("name").(string)),
+				Domain:          pagesProjectPreviewAccessDomain(subdomain),
+				Type:            cloudflare.SelfHosted,
+				SessionDuration: "24h",
+			})
+			if err != nil {
+				return fmt.Errorf("error creating preview Access application of Pages project %q: %w", d.Id(), err)
+			}
+			app = &created
+		}
+		d.Set("preview_access_application_id", app.ID)
+		return nil
+	}
+
+	if app != nil {
+		if err := client.DeleteAccessApplication(ctx, accountID, app.ID); err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+			return fmt.Errorf("error deleting preview Access application of Pages project %q: %w", d.Id(), err)
+		}
+	}
+	d.Set("preview_access_application_id", "")
+	return nil
+}
+
+func getPagesProject(ctx context.Context, client *cloudflare.API, accountID, projectName string) (pagesProject, error) {
+	var project pagesProject
+
+	res, err := rawConditionalGet(ctx, client, pagesProjectEndpoint(accountID, projectName))
+	if err != nil {
+		return project, err
+	}
+
+	if err := json.Unmarshal(res, &project); err != nil {
+		return project, fmt.Errorf("error unmarshalling Pages project: %w", err)
+	}
+
+	return project, nil
+}
+
+func resourceCloudflarePagesProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	project := pagesProjectFromResource(d)
+
+	_, err := client.Raw(http.MethodPost, pagesProjectEndpoint(accountID, ""), project)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Pages project %q: %w", project.Name, err))
+	}
+
+	d.SetId(project.Name)
+
+	if d.Get("enable_preview_access").(bool) {
+		created, err := getPagesProject(ctx, client, accountID, d.Id())
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading Pages project %q: %w", d.Id(), err))
+		}
+		if err := syncPagesProjectPreviewAccess(ctx, client, d, created.SubDomain); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudflarePagesProjectRead(ctx, d, meta)
+}
+
+func resourceCloudflarePagesProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	project, err := getPagesProject(ctx, client, accountID, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Pages project %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", project.Name)
+	d.Set("production_branch", project.ProductionBranch)
+	d.Set("subdomain", project.SubDomain)
+	d.Set("domains", project.Domains)
+	d.Set("created_on", project.CreatedOn)
+
+	if project.SubDomain != "" {
+		app, err := findPagesProjectPreviewAccessApplication(ctx, client, accountID, project.SubDomain)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error looking up preview Access application of Pages project %q: %w", d.Id(), err))
+		}
+		d.Set("enable_preview_access", app != nil)
+		if app != nil {
+			d.Set("preview_access_application_id", app.ID)
+		} else {
+			d.Set("preview_access_application_id", "")
+		}
+	}
+
+	if project.Source != nil {
+		source := map[string]interface{}{"type": project.Source.Type}
+		if cfg := project.Source.Config; cfg != nil {
+			source["config"] = []interface{}{map[string]interface{}{
+				"owner":                      cfg.Owner,
+				"repo_name":                  cfg.RepoName,
+				"production_branch":          cfg.ProductionBranch,
+				"pr_comments_enabled":        cfg.PRCommentsEnabled,
+				"deployments_enabled":        cfg.DeploymentsEnabled,
+				"preview_deployment_setting": cfg.PreviewDeploymentSetting,
+				"preview_branch_includes":    cfg.PreviewBranchIncludes,
+				"preview_branch_excludes":    cfg.PreviewBranchExcludes,
+			}}
+		}
+		d.Set("source", []interface{}{source})
+	} else {
+		d.Set("source", nil)
+	}
+
+	if cfg := project.BuildConfig; cfg != nil {
+		d.Set("build_config", []interface{}{map[string]interface{}{
+			"build_command":       cfg.BuildCommand,
+			"destination_dir":     cfg.DestinationDir,
+			"root_dir":            cfg.RootDir,
+			"web_analytics_tag":   cfg.WebAnalyticsTag,
+			"web_analytics_token": cfg.WebAnalyticsToken,
+		}})
+	} else {
+		d.Set("build_config", nil)
+	}
+
+	if configs := project.DeploymentConfigs; configs != nil {
+		previewSecrets, _ := d.Get("deployment_configs.0.preview.0.secrets").(map[string]interface{})
+		productionSecrets, _ := d.Get("deployment_configs.0.production.0.secrets").(map[string]interface{})
+
+		d.Set("deployment_configs", []interface{}{map[string]interface{}{
+			"preview":    flattenPagesProjectDeploymentConfig(configs.Preview, previewSecrets),
+			"production": flattenPagesProjectDeploymentConfig(configs.Production, productionSecrets),
+		}})
+	}
+
+	return nil
+}
+
+func resourceCloudflarePagesProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	project := pagesProjectFromResource(d)
+
+	_, err := client.Raw(http.MethodPatch, pagesProjectEndpoint(accountID, d.Id()), project)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Pages project %q: %w", d.Id(), err))
+	}
+
+	if d.HasChange("enable_preview_access") {
+		if err := syncPagesProjectPreviewAccess(ctx, client, d, d.Get("subdomain").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudflarePagesProjectRead(ctx, d, meta)
+}
+
+func resourceCloudflarePagesProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	if appID := d.Get("preview_access_application_id").(string); appID != "" {
+		if err := client.DeleteAccessApplication(ctx, accountID, appID); err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+			return diag.FromErr(fmt.Errorf("error deleting preview Access application of Pages project %q: %w", d.Id(), err))
+		}
+	}
+
+	_, err := client.Raw(http.MethodDelete, pagesProjectEndpoint(accountID, d.Id()), nil)
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error deleting Pages project %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflarePagesProjectImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/projectName\"", d.Id())
+	}
+
+	accountID, projectName := attributes[0], attributes[1]
+	d.Set("account_id", accountID)
+	d.SetId(projectName)
+
+	resourceCloudflarePagesProjectRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
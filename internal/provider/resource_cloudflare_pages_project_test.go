@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflarePagesProject(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_pages_project.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflarePagesProjectConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "production_branch", "main"),
+					resource.TestCheckResourceAttrSet(name, "subdomain"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflarePagesProjectDeploymentConfigs(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_pages_project.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflarePagesProjectDeploymentConfigs(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.production.0.environment_variables.ENVIRONMENT", "production"),
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.production.0.compatibility_date", "2022-08-15"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflarePagesProjectConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_pages_project" "%[1]s" {
+		account_id        = "%[2]s"
+		name              = "%[1]s"
+		production_branch = "main"
+	}`, name, accountID)
+}
+
+func testAccCloudflarePagesProjectDeploymentConfigs(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_pages_project" "%[1]s" {
+		account_id        = "%[2]s"
+		name              = "%[1]s"
+		production_branch = "main"
+
+		deployment_configs {
+			production {
+				environment_variables = {
+					ENVIRONMENT = "production"
+				}
+				compatibility_date = "2022-08-15"
+			}
+		}
+	}`, name, accountID)
+}
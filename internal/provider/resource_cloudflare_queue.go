@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// queueSettings and queue are the API representation of a Cloudflare Queue,
+// which the pinned client does not cover.
+type queueSettings struct {
+	DeliveryDelay          int `json:"delivery_delay"`
+	MessageRetentionPeriod int `json:"message_retention_period,omitempty"`
+}
+
+type queue struct {
+	ID       string         `json:"queue_id,omitempty"`
+	Name     string         `json:"queue_name"`
+	Settings *queueSettings `json:"settings,omitempty"`
+}
+
+func resourceCloudflareQueue() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareQueueSchema(),
+		CreateContext: resourceCloudflareQueueCreate,
+		ReadContext:   resourceCloudflareQueueRead,
+		UpdateContext: resourceCloudflareQueueUpdate,
+		DeleteContext: resourceCloudflareQueueDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareQueueImport,
+		},
+		Description: "Provides a resource which manages a Cloudflare Queue. Messages are produced to the queue from Workers through a `queue_binding` and consumed by a `cloudflare_queue_consumer`.",
+	}
+}
+
+func queueFromResource(d *schema.ResourceData) queue {
+	return queue{
+		Name: d.Get("name").(string),
+		Settings: &queueSettings{
+			DeliveryDelay:          d.Get("delivery_delay").(int),
+			MessageRetentionPeriod: d.Get("message_retention_period").(int),
+		},
+	}
+}
+
+func resourceCloudflareQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+
+	res, err := client.Raw(http.MethodPost, fmt.Sprintf("/accounts/%s/queues", accountID), queueFromResource(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating queue %q: %w", name, err))
+	}
+
+	var created queue
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling queue: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceCloudflareQueueRead(ctx, d, meta)
+}
+
+func resourceCloudflareQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/accounts/%s/queues/%s", accountID, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading queue %q: %w", d.Id(), err))
+	}
+
+	var q queue
+	if err := json.Unmarshal(res, &q); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling queue: %w", err))
+	}
+
+	d.Set("name", q.Name)
+	if q.Settings != nil {
+		d.Set("delivery_delay", q.Settings.DeliveryDelay)
+		if q.Settings.MessageRetentionPeriod > 0 {
+			d.Set("message_retention_period", q.Settings.MessageRetentionPeriod)
+		}
+	}
+
+	return nil
+}
+
+func resourceCloudflareQueueUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	if _, err := client.Raw(http.MethodPut, fmt.Sprintf("/accounts/%s/queues/%s", accountID, d.Id()), queueFromResource(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating queue %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareQueueRead(ctx, d, meta)
+}
+
+func resourceCloudflareQueueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	_, err := client.Raw(http.MethodDelete, fmt.Sprintf("/accounts/%s/queues/%s", accountID, d.Id()), nil)
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error deleting queue %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareQueueImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/queueID\"", d.Id())
+	}
+
+	accountID, queueID := attributes[0], attributes[1]
+	d.Set("account_id", accountID)
+	d.SetId(queueID)
+
+	resourceCloudflareQueueRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// queueConsumerSettings and queueConsumer are the API representation of a
+// queue consumer, which the pinned client does not cover.
+type queueConsumerSettings struct {
+	BatchSize           int `json:"batch_size,omitempty"`
+	MaxRetries          int `json:"max_retries"`
+	RetryDelay          int `json:"retry_delay,omitempty"`
+	MaxWaitTimeMs       int `json:"max_wait_time_ms,omitempty"`
+	MaxConcurrency      int `json:"max_concurrency,omitempty"`
+	VisibilityTimeoutMs int `json:"visibility_timeout_ms,omitempty"`
+}
+
+type queueConsumer struct {
+	ID              string                 `json:"consumer_id,omitempty"`
+	Type            string                 `json:"type,omitempty"`
+	ScriptName      string                 `json:"script_name,omitempty"`
+	Environment     string                 `json:"environment,omitempty"`
+	DeadLetterQueue string                 `json:"dead_letter_queue,omitempty"`
+	Settings        *queueConsumerSettings `json:"settings,omitempty"`
+}
+
+func resourceCloudflareQueueConsumer() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareQueueConsumerSchema(),
+		CreateContext: resourceCloudflareQueueConsumerCreate,
+		ReadContext:   resourceCloudflareQueueConsumerRead,
+		UpdateContext: resourceCloudflareQueueConsumerUpdate,
+		DeleteContext: resourceCloudflareQueueConsumerDelete,
+		CustomizeDiff: resourceCloudflareQueueConsumerDiff,
+		Description:   "Provides a resource which attaches a consumer to a `cloudflare_queue`, either a Worker receiving message batches or an HTTP pull consumer.",
+	}
+}
+
+func resourceCloudflareQueueConsumerDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	switch d.Get("type").(string) {
+	case "worker":
+		if d.Get("script_name").(string) == "" {
+			return fmt.Errorf("script_name is required for worker consumers")
+		}
+	case "http_pull":
+		if d.Get("script_name").(string) != "" {
+			return fmt.Errorf("script_name cannot be set for http_pull consumers")
+		}
+	}
+	return nil
+}
+
+func queueConsumerFromResource(d *schema.ResourceData) queueConsumer {
+	consumerType := d.Get("type").(string)
+
+	settings := &queueConsumerSettings{
+		BatchSize:  d.Get("batch_size").(int),
+		MaxRetries: d.Get("max_retries").(int),
+		RetryDelay: d.Get("retry_delay").(int),
+	}
+	if consumerType == "http_pull" {
+		settings.VisibilityTimeoutMs = d.Get("visibility_timeout_ms").(int)
+	} else {
+		settings.MaxWaitTimeMs = d.Get("max_wait_time_ms").(int)
+		settings.MaxConcurrency = d.Get("max_concurrency").(int)
+	}
+
+	return queueConsumer{
+		Type:            consumerType,
+		ScriptName:      d.Get("script_name").(string),
+		Environment:     d.Get("environment").(string),
+		DeadLetterQueue: d.Get("dead_letter_queue").(string),
+		Settings:        settings,
+	}
+}
+
+func queueConsumerEndpoint(accountID, queueID, consumerID string) string {
+	if consumerID == "" {
+		return fmt.Sprintf("/accounts/%s/queues/%s/consumers", accountID, queueID)
+	}
+	return fmt.Sprintf("/accounts/%s/queues/%s/consumers/%s", accountID, queueID, consumerID)
+}
+
+func resourceCloudflareQueueConsumerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	queueID := d.Get("queue_id").(string)
+
+	res, err := client.Raw(http.MethodPost, queueConsumerEndpoint(accountID, queueID, ""), queueConsumerFromResource(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating consumer for queue %q: %w", queueID, err))
+	}
+
+	var created queueConsumer
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling queue consumer: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceCloudflareQueueConsumerRead(ctx, d, meta)
+}
+
+func resourceCloudflareQueueConsumerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	queueID := d.Get("queue_id").(string)
+
+	// there is no endpoint for a single consumer, so it is looked up in the
+	// queue's consumer list
+	res, err := client.Raw(http.MethodGet, queueConsumerEndpoint(accountID, queueID, ""), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error listing consumers of queue %q: %w", queueID, err))
+	}
+
+	var consumers []queueConsumer
+	if err := json.Unmarshal(res, &consumers); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling queue consumers: %w", err))
+	}
+
+	for _, consumer := range consumers {
+		if consumer.ID != d.Id() {
+			continue
+		}
+
+		d.Set("type", consumer.Type)
+		d.Set("script_name", consumer.ScriptName)
+		d.Set("environment", consumer.Environment)
+		d.Set("dead_letter_queue", consumer.DeadLetterQueue)
+		if consumer.Settings != nil {
+			d.Set("batch_size", consumer.Settings.BatchSize)
+			d.Set("max_retries", consumer.Settings.MaxRetries)
+			d.Set("retry_delay", consumer.Settings.RetryDelay)
+			if consumer.Type == "http_pull" {
+				d.Set("visibility_timeout_ms", consumer.Settings.VisibilityTimeoutMs)
+			} else {
+				d.Set("max_wait_time_ms", consumer.Settings.MaxWaitTimeMs)
+				d.Set("max_concurrency", consumer.Settings.MaxConcurrency)
+			}
+		}
+
+		return nil
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudflareQueueConsumerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	queueID := d.Get("queue_id").(string)
+
+	if _, err := client.Raw(http.MethodPut, queueConsumerEndpoint(accountID, queueID, d.Id()), queueConsumerFromResource(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating consumer %q of queue %q: %w", d.Id(), queueID, err))
+	}
+
+	return resourceCloudflareQueueConsumerRead(ctx, d, meta)
+}
+
+func resourceCloudflareQueueConsumerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	queueID := d.Get("queue_id").(string)
+
+	_, err := client.Raw(http.MethodDelete, queueConsumerEndpoint(accountID, queueID, d.Id()), nil)
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error deleting consumer %q of queue %q: %w", d.Id(), queueID, err))
+	}
+
+	return nil
+}
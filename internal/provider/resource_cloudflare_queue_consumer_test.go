@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareQueueConsumer(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_queue_consumer.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareQueueConsumerConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "script_name", rnd),
+					resource.TestCheckResourceAttr(name, "batch_size", "10"),
+					resource.TestCheckResourceAttr(name, "max_retries", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareQueueConsumerConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_queue" "%[1]s" {
+		account_id = "%[2]s"
+		name       = "%[1]s"
+	}
+
+	resource "cloudflare_worker_script" "%[1]s" {
+		name    = "%[1]s"
+		content = "export default { async queue(batch, env) {} }"
+		module  = true
+	}
+
+	resource "cloudflare_queue_consumer" "%[1]s" {
+		account_id  = "%[2]s"
+		queue_id    = cloudflare_queue.%[1]s.id
+		script_name = cloudflare_worker_script.%[1]s.name
+		batch_size  = 10
+		max_retries = 3
+	}`, name, accountID)
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type r2CORSAllowed struct {
+	Origins []string `json:"origins"`
+	Methods []string `json:"methods"`
+	Headers []string `json:"headers,omitempty"`
+}
+
+type r2CORSRule struct {
+	ID            string        `json:"id,omitempty"`
+	Allowed       r2CORSAllowed `json:"allowed"`
+	ExposeHeaders []string      `json:"exposeHeaders,omitempty"`
+	MaxAgeSeconds int           `json:"maxAgeSeconds,omitempty"`
+}
+
+func resourceCloudflareR2BucketCORS() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareR2BucketCORSSchema(),
+		CreateContext: resourceCloudflareR2BucketCORSCreate,
+		ReadContext:   resourceCloudflareR2BucketCORSRead,
+		UpdateContext: resourceCloudflareR2BucketCORSCreate,
+		DeleteContext: resourceCloudflareR2BucketCORSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareR2BucketCORSImport,
+		},
+		Description: "Provides a resource which manages the CORS policy of an R2 bucket.",
+	}
+}
+
+func r2BucketCORSEndpoint(accountID, bucketName string) string {
+	return fmt.Sprintf("%s/cors", r2BucketEndpoint(accountID, bucketName))
+}
+
+func expandR2CORSRules(raw []interface{}) []r2CORSRule {
+	rules := []r2CORSRule{}
+	for _, rawRule := range raw {
+		cfg := rawRule.(map[string]interface{})
+		rules = append(rules, r2CORSRule{
+			ID: cfg["id"].(string),
+			Allowed: r2CORSAllowed{
+				Origins: expandInterfaceToStringList(cfg["allowed_origins"]),
+				Methods: expandInterfaceToStringList(cfg["allowed_methods"]),
+				Headers: expandInterfaceToStringList(cfg["allowed_headers"]),
+			},
+			ExposeHeaders: expandInterfaceToStringList(cfg["expose_headers"]),
+			MaxAgeSeconds: cfg["max_age_seconds"].(int),
+		})
+	}
+	return rules
+}
+
+func flattenR2CORSRules(rules []r2CORSRule) []interface{} {
+	flattened := []interface{}{}
+	for _, rule := range rules {
+		flattened = append(flattened, map[string]interface{}{
+			"id":              rule.ID,
+			"allowed_origins": rule.Allowed.Origins,
+			"allowed_methods": rule.Allowed.Methods,
+			"allowed_headers": rule.Allowed.Headers,
+			"expose_headers":  rule.ExposeHeaders,
+			"max_age_seconds": rule.MaxAgeSeconds,
+		})
+	}
+	return flattened
+}
+
+func resourceCloudflareR2BucketCORSCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	bucketName := d.Get("bucket").(string)
+
+	_, err := r2BucketCall(ctx, client, http.MethodPut, r2BucketCORSEndpoint(accountID, bucketName), d.Get("jurisdiction").(string), nil, map[string]interface{}{
+		"rules": expandR2CORSRules(d.Get("rule").([]interface{})),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting CORS policy of R2 bucket %q: %w", bucketName, err))
+	}
+
+	d.SetId(bucketName)
+
+	return resourceCloudflareR2BucketCORSRead(ctx, d, meta)
+}
+
+func resourceCloudflareR2BucketCORSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := r2BucketCall(ctx, client, http.MethodGet, r2BucketCORSEndpoint(accountID, d.Id()), d.Get("jurisdiction").(string), nil, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading CORS policy of R2 bucket %q: %w", d.Id(), err))
+	}
+
+	var cors struct {
+		Rules []r2CORSRule `json:"rules"`
+	}
+	if err := json.Unmarshal(res, &cors); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling CORS policy: %w", err))
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("rule", flattenR2CORSRules(cors.Rules))
+
+	return nil
+}
+
+func resourceCloudflareR2BucketCORSDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	_, err := r2BucketCall(ctx, client, http.MethodDelete, r2BucketCORSEndpoint(accountID, d.Id()), d.Get("jurisdiction").(string), nil, nil)
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error deleting CORS policy of R2 bucket %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareR2BucketCORSImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) < 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/bucketName\" or \"accountID/bucketName/jurisdiction\"", d.Id())
+	}
+
+	d.Set("account_id", attributes[0])
+	if len(attributes) == 3 {
+		d.Set("jurisdiction", attributes[2])
+	} else {
+		d.Set("jurisdiction", "default")
+	}
+	d.SetId(attributes[1])
+
+	resourceCloudflareR2BucketCORSRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
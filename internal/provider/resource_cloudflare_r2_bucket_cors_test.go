@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareR2BucketCORS(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_r2_bucket_cors.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareR2BucketCORSConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "bucket", rnd),
+					resource.TestCheckResourceAttr(name, "rule.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule.0.allowed_origins.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(name, "rule.0.max_age_seconds", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareR2BucketCORSConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_r2_bucket" "%[1]s" {
+		account_id = "%[2]s"
+		name       = "%[1]s"
+	}
+
+	resource "cloudflare_r2_bucket_cors" "%[1]s" {
+		account_id = "%[2]s"
+		bucket     = cloudflare_r2_bucket.%[1]s.name
+
+		rule {
+			allowed_origins = ["https://app.example.com"]
+			allowed_methods = ["GET", "PUT"]
+			allowed_headers = ["Content-Type"]
+			max_age_seconds = 3600
+		}
+	}`, name, accountID)
+}
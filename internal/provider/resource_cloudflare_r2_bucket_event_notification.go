@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type r2EventNotificationRule struct {
+	RuleID  string   `json:"ruleId,omitempty"`
+	Prefix  string   `json:"prefix,omitempty"`
+	Suffix  string   `json:"suffix,omitempty"`
+	Actions []string `json:"actions"`
+}
+
+func resourceCloudflareR2BucketEventNotification() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareR2BucketEventNotificationSchema(),
+		CreateContext: resourceCloudflareR2BucketEventNotificationCreate,
+		ReadContext:   resourceCloudflareR2BucketEventNotificationRead,
+		UpdateContext: resourceCloudflareR2BucketEventNotificationCreate,
+		DeleteContext: resourceCloudflareR2BucketEventNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareR2BucketEventNotificationImport,
+		},
+		Description: "Provides a resource which manages the event notification rules that send object change events of an R2 bucket to a Queue.",
+	}
+}
+
+func r2BucketEventNotificationEndpoint(accountID, bucketName, queueID string) string {
+	endpoint := fmt.Sprintf("/accounts/%s/event_notifications/r2/%s/configuration", accountID, bucketName)
+	if queueID != "" {
+		endpoint = fmt.Sprintf("%s/queues/%s", endpoint, queueID)
+	}
+	return endpoint
+}
+
+func expandR2EventNotificationRules(raw []interface{}) []r2EventNotificationRule {
+	rules := []r2EventNotificationRule{}
+	for _, rawRule := range raw {
+		cfg := rawRule.(map[string]interface{})
+		rules = append(rules, r2EventNotificationRule{
+			Prefix:  cfg["prefix"].(string),
+			Suffix:  cfg["suffix"].(string),
+			Actions: expandInterfaceToStringList(cfg["actions"]),
+		})
+	}
+	return rules
+}
+
+func flattenR2EventNotificationRules(rules []r2EventNotificationRule) []interface{} {
+	flattened := []interface{}{}
+	for _, rule := range rules {
+		flattened = append(flattened, map[string]interface{}{
+			"prefix":  rule.Prefix,
+			"suffix":  rule.Suffix,
+			"actions": rule.Actions,
+		})
+	}
+	return flattened
+}
+
+func resourceCloudflareR2BucketEventNotificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	bucketName := d.Get("bucket").(string)
+	queueID := d.Get("queue_id").(string)
+
+	_, err := r2BucketCall(ctx, client, http.MethodPut, r2BucketEventNotificationEndpoint(accountID, bucketName, queueID), d.Get("jurisdiction").(string), nil, map[string]interface{}{
+		"rules": expandR2EventNotificationRules(d.Get("rule").([]interface{})),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting event notification rules of R2 bucket %q for queue %q: %w", bucketName, queueID, err))
+	}
+
+	d.SetId(stringChecksum(fmt.Sprintf("event-notification/%s/%s", bucketName, queueID)))
+
+	return resourceCloudflareR2BucketEventNotificationRead(ctx, d, meta)
+}
+
+func resourceCloudflareR2BucketEventNotificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	bucketName := d.Get("bucket").(string)
+	queueID := d.Get("queue_id").(string)
+
+	res, err := r2BucketCall(ctx, client, http.MethodGet, r2BucketEventNotificationEndpoint(accountID, bucketName, ""), d.Get("jurisdiction").(string), nil, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading event notification rules of R2 bucket %q: %w", bucketName, err))
+	}
+
+	var configuration struct {
+		Queues []struct {
+			QueueID string                    `json:"queueId"`
+			Rules   []r2EventNotificationRule `json:"rules"`
+		} `json:"queues"`
+	}
+	if err := json.Unmarshal(res, &configuration); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling event notification rules: %w", err))
+	}
+
+	for _, queue := range configuration.Queues {
+		if queue.QueueID == queueID {
+			d.Set("rule", flattenR2EventNotificationRules(queue.Rules))
+			return nil
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudflareR2BucketEventNotificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	bucketName := d.Get("bucket").(string)
+	queueID := d.Get("queue_id").(string)
+
+	_, err := r2BucketCall(ctx, client, http.MethodDelete, r2BucketEventNotificationEndpoint(accountID, bucketName, queueID), d.Get("jurisdiction").(string), nil, nil)
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error deleting event notification rules of R2 bucket %q for queue %q: %w", bucketName, queueID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareR2BucketEventNotificationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 4)
+
+	if len(attributes) < 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/bucketName/queueID\" or \"accountID/bucketName/queueID/jurisdiction\"", d.Id())
+	}
+
+	d.Set("account_id", attributes[0])
+	d.Set("bucket", attributes[1])
+	d.Set("queue_id", attributes[2])
+	if len(attributes) == 4 {
+		d.Set("jurisdiction", attributes[3])
+	} else {
+		d.Set("jurisdiction", "default")
+	}
+	d.SetId(stringChecksum(fmt.Sprintf("event-notification/%s/%s", attributes[1], attributes[2])))
+
+	resourceCloudflareR2BucketEventNotificationRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
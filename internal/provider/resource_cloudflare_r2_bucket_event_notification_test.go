@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareR2BucketEventNotification(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_r2_bucket_event_notification.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareR2BucketEventNotificationConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "bucket", rnd),
+					resource.TestCheckResourceAttr(name, "rule.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule.0.prefix", "uploads/"),
+					resource.TestCheckResourceAttr(name, "rule.0.actions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareR2BucketEventNotificationConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_r2_bucket" "%[1]s" {
+		account_id = "%[2]s"
+		name       = "%[1]s"
+	}
+
+	resource "cloudflare_queue" "%[1]s" {
+		account_id = "%[2]s"
+		name       = "%[1]s"
+	}
+
+	resource "cloudflare_r2_bucket_event_notification" "%[1]s" {
+		account_id = "%[2]s"
+		bucket     = cloudflare_r2_bucket.%[1]s.name
+		queue_id   = cloudflare_queue.%[1]s.id
+
+		rule {
+			prefix  = "uploads/"
+			actions = ["PutObject"]
+		}
+	}`, name, accountID)
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// r2LifecycleCondition is the age or date condition of a lifecycle
+// transition. Ages are in seconds.
+type r2LifecycleCondition struct {
+	Type   string `json:"type"`
+	MaxAge int    `json:"maxAge,omitempty"`
+	Date   string `json:"date,omitempty"`
+}
+
+type r2LifecycleTransition struct {
+	Condition r2LifecycleCondition `json:"condition"`
+}
+
+type r2LifecycleStorageClassTransition struct {
+	Condition    r2LifecycleCondition `json:"condition"`
+	StorageClass string               `json:"storageClass"`
+}
+
+type r2LifecycleRuleConditions struct {
+	Prefix string `json:"prefix"`
+}
+
+type r2LifecycleRule struct {
+	ID                              string                              `json:"id"`
+	Enabled                         bool                                `json:"enabled"`
+	Conditions                      r2LifecycleRuleConditions           `json:"conditions"`
+	DeleteObjectsTransition         *r2LifecycleTransition              `json:"deleteObjectsTransition,omitempty"`
+	StorageClassTransitions         []r2LifecycleStorageClassTransition `json:"storageClassTransitions,omitempty"`
+	AbortMultipartUploadsTransition *r2LifecycleTransition              `json:"abortMultipartUploadsTransition,omitempty"`
+}
+
+func resourceCloudflareR2BucketLifecycle() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareR2BucketLifecycleSchema(),
+		CreateContext: resourceCloudflareR2BucketLifecycleCreate,
+		ReadContext:   resourceCloudflareR2BucketLifecycleRead,
+		UpdateContext: resourceCloudflareR2BucketLifecycleCreate,
+		DeleteContext: resourceCloudflareR2BucketLifecycleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareR2BucketLifecycleImport,
+		},
+		Description: "Provides a resource which manages the lifecycle rules of an R2 bucket: object expiration, transition to the Infrequent Access storage class and cleanup of incomplete multipart uploads.",
+	}
+}
+
+func r2BucketLifecycleEndpoint(accountID, bucketName string) string {
+	return fmt.Sprintf("%s/lifecycle", r2BucketEndpoint(accountID, bucketName))
+}
+
+func expandR2LifecycleCondition(raw []interface{}) r2LifecycleCondition {
+	condition := r2LifecycleCondition{Type: "Age"}
+	if len(raw) == 0 || raw[0] == nil {
+		return condition
+	}
+	cfg := raw[0].(map[string]interface{})
+	if date := cfg["date"].(string); date != "" {
+		return r2LifecycleCondition{Type: "Date", Date: date}
+	}
+	condition.MaxAge = cfg["max_age_seconds"].(int)
+	return condition
+}
+
+func flattenR2LifecycleCondition(condition r2LifecycleCondition) []interface{} {
+	if condition.Type == "Date" {
+		return []interface{}{map[string]interface{}{"date": condition.Date}}
+	}
+	return []interface{}{map[string]interface{}{"max_age_seconds": condition.MaxAge}}
+}
+
+func expandR2LifecycleRules(raw []interface{}) []r2LifecycleRule {
+	rules := []r2LifecycleRule{}
+	for _, rawRule := range raw {
+		cfg := rawRule.(map[string]interface{})
+		rule := r2LifecycleRule{
+			ID:         cfg["id"].(string),
+			Enabled:    cfg["enabled"].(bool),
+			Conditions: r2LifecycleRuleConditions{Prefix: cfg["prefix"].(string)},
+		}
+
+		if expiration := cfg["expiration"].([]interface{}); len(expiration) > 0 {
+			rule.DeleteObjectsTransition = &r2LifecycleTransition{Condition: expandR2LifecycleCondition(expiration)}
+		}
+		if transition := cfg["infrequent_access_transition"].([]interface{}); len(transition) > 0 {
+			rule.StorageClassTransitions = []r2LifecycleStorageClassTransition{{
+				Condition:    expandR2LifecycleCondition(transition),
+				StorageClass: "InfrequentAccess",
+			}}
+		}
+		if maxAge := cfg["abort_multipart_uploads_max_age_seconds"].(int); maxAge > 0 {
+			rule.AbortMultipartUploadsTransition = &r2LifecycleTransition{Condition: r2LifecycleCondition{Type: "Age", MaxAge: maxAge}}
+		}
+
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenR2LifecycleRules(rules []r2LifecycleRule) []interface{} {
+	flattened := []interface{}{}
+	for _, rule := range rules {
+		cfg := map[string]interface{}{
+			"id":      rule.ID,
+			"enabled": rule.Enabled,
+			"prefix":  rule.Conditions.Prefix,
+		}
+		if rule.DeleteObjectsTransition != nil {
+			cfg["expiration"] = flattenR2LifecycleCondition(rule.DeleteObjectsTransition.Condition)
+		}
+		if len(rule.StorageClassTransitions) > 0 {
+			cfg["infrequent_access_transition"] = flattenR2LifecycleCondition(rule.StorageClassTransitions[0].Condition)
+		}
+		if rule.AbortMultipartUploadsTransition != nil {
+			cfg["abort_multipart_uploads_max_age_seconds"] = rule.AbortMultipartUploadsTransition.Condition.MaxAge
+		}
+		flattened = append(flattened, cfg)
+	}
+	return flattened
+}
+
+func resourceCloudflareR2BucketLifecycleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	bucketName := d.Get("bucket").(string)
+
+	_, err := r2BucketCall(ctx, client, http.MethodPut, r2BucketLifecycleEndpoint(accountID, bucketName), d.Get("jurisdiction").(string), nil, map[string]interface{}{
+		"rules": expandR2LifecycleRules(d.Get("rule").([]interface{})),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting lifecycle rules of R2 bucket %q: %w", bucketName, err))
+	}
+
+	d.SetId(bucketName)
+
+	return resourceCloudflareR2BucketLifecycleRead(ctx, d, meta)
+}
+
+func resourceCloudflareR2BucketLifecycleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := r2BucketCall(ctx, client, http.MethodGet, r2BucketLifecycleEndpoint(accountID, d.Id()), d.Get("jurisdiction").(string), nil, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading lifecycle rules of R2 bucket %q: %w", d.Id(), err))
+	}
+
+	var lifecycle struct {
+		Rules []r2LifecycleRule `json:"rules"`
+	}
+	if err := json.Unmarshal(res, &lifecycle); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling lifecycle rules: %w", err))
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("rule", flattenR2LifecycleRules(lifecycle.Rules))
+
+	return nil
+}
+
+func resourceCloudflareR2BucketLifecycleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	_, err := r2BucketCall(ctx, client, http.MethodPut, r2BucketLifecycleEndpoint(accountID, d.Id()), d.Get("jurisdiction").(string), nil, map[string]interface{}{
+		"rules": []r2LifecycleRule{},
+	})
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error clearing lifecycle rules of R2 bucket %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareR2BucketLifecycleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) < 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/bucketName\" or \"accountID/bucketName/jurisdiction\"", d.Id())
+	}
+
+	d.Set("account_id", attributes[0])
+	if len(attributes) == 3 {
+		d.Set("jurisdiction", attributes[2])
+	} else {
+		d.Set("jurisdiction", "default")
+	}
+	d.SetId(attributes[1])
+
+	resourceCloudflareR2BucketLifecycleRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareR2BucketLifecycle(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_r2_bucket_lifecycle.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareR2BucketLifecycleConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "bucket", rnd),
+					resource.TestCheckResourceAttr(name, "rule.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule.0.id", "expire-tmp"),
+					resource.TestCheckResourceAttr(name, "rule.0.prefix", "tmp/"),
+					resource.TestCheckResourceAttr(name, "rule.0.expiration.0.max_age_seconds", "86400"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareR2BucketLifecycleConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_r2_bucket" "%[1]s" {
+		account_id = "%[2]s"
+		name       = "%[1]s"
+	}
+
+	resource "cloudflare_r2_bucket_lifecycle" "%[1]s" {
+		account_id = "%[2]s"
+		bucket     = cloudflare_r2_bucket.%[1]s.name
+
+		rule {
+			id      = "expire-tmp"
+			enabled = true
+			prefix  = "tmp/"
+
+			expiration {
+				max_age_seconds = 86400
+			}
+		}
+	}`, name, accountID)
+}
@@ -14,11 +14,12 @@ import (
 
 func resourceCloudflareRateLimit() *schema.Resource {
 	return &schema.Resource{
-		Schema:        resourceCloudflareRateLimitSchema(),
-		CreateContext: resourceCloudflareRateLimitCreate,
-		ReadContext:   resourceCloudflareRateLimitRead,
-		UpdateContext: resourceCloudflareRateLimitUpdate,
-		DeleteContext: resourceCloudflareRateLimitDelete,
+		DeprecationMessage: "`cloudflare_rate_limit` targets the legacy rate limiting API which is being sunset. Use a `cloudflare_ruleset` in the `http_ratelimit` phase instead; set `read_only = true` to freeze an existing rate limit and surface its equivalent ruleset configuration.",
+		Schema:             resourceCloudflareRateLimitSchema(),
+		CreateContext:      resourceCloudflareRateLimitCreate,
+		ReadContext:        resourceCloudflareRateLimitRead,
+		UpdateContext:      resourceCloudflareRateLimitUpdate,
+		DeleteContext:      resourceCloudflareRateLimitDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareRateLimitImport,
 		},
@@ -30,6 +31,14 @@ func resourceCloudflareRateLimitCreate(ctx context.Context, d *schema.ResourceDa
 
 	zoneID := d.Get("zone_id").(string)
 
+	if d.Get("read_only").(bool) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "cannot create a legacy rate limit in read-only mode",
+			Detail:   "The legacy rate limiting API is being sunset. Create the equivalent rule in a `cloudflare_ruleset` in the `http_ratelimit` phase instead:\n\n" + rateLimitEquivalentRulesetConfig(d),
+		}}
+	}
+
 	rateLimitAction, err := expandRateLimitAction(ctx, d)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error expanding rate limit action"))
@@ -91,6 +100,19 @@ func resourceCloudflareRateLimitUpdate(ctx context.Context, d *schema.ResourceDa
 	zoneID := d.Get("zone_id").(string)
 	rateLimitId := d.Id()
 
+	if d.Get("read_only").(bool) {
+		// switching read_only on is the one change that does not need an API
+		// call; anything else is refused so the legacy rate limit is frozen
+		if !d.HasChangesExcept("read_only") {
+			return resourceCloudflareRateLimitRead(ctx, d, meta)
+		}
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "cannot update a legacy rate limit in read-only mode",
+			Detail:   "The legacy rate limiting API is being sunset. Migrate this rate limit to a `cloudflare_ruleset` in the `http_ratelimit` phase instead:\n\n" + rateLimitEquivalentRulesetConfig(d),
+		}}
+	}
+
 	rateLimitAction, err := expandRateLimitAction(ctx, d)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error expanding rate limit action"))
@@ -304,9 +326,59 @@ func resourceCloudflareRateLimitRead(ctx context.Context, d *schema.ResourceData
 		tflog.Warn(ctx, fmt.Sprintf("Error setting bypass_url_patterns on rate limit %q: %s", d.Id(), err))
 	}
 
+	if d.Get("read_only").(bool) {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("rate limit %s is in read-only mode", d.Id()),
+			Detail:   "The legacy rate limiting API is being sunset. Migrate this rate limit to a `cloudflare_ruleset` in the `http_ratelimit` phase:\n\n" + rateLimitEquivalentRulesetConfig(d),
+		}}
+	}
+
 	return nil
 }
 
+// rateLimitEquivalentRulesetConfig renders the cloudflare_ruleset
+// configuration equivalent to the legacy rate limit held in d, to be included
+// in the read-only mode diagnostics as a migration starting point.
+func rateLimitEquivalentRulesetConfig(d *schema.ResourceData) string {
+	expression := "true"
+	if urlPattern := d.Get("match.0.request.0.url_pattern").(string); urlPattern != "" && urlPattern != "*" {
+		expression = fmt.Sprintf("(http.request.uri matches %q)", strings.ReplaceAll(urlPattern, "*", ".*"))
+	}
+
+	action := d.Get("action.0.mode").(string)
+	switch action {
+	case "ban":
+		action = "block"
+	case "simulate":
+		action = "log"
+	}
+
+	timeout := d.Get("action.0.timeout").(int)
+	if timeout == 0 {
+		timeout = d.Get("period").(int)
+	}
+
+	return fmt.Sprintf(`resource "cloudflare_ruleset" "rate_limit" {
+  zone_id = %q
+  name    = "Rate limiting"
+  kind    = "zone"
+  phase   = "http_ratelimit"
+
+  rules {
+    description = %q
+    expression  = %q
+    action      = %q
+    ratelimit {
+      characteristics     = ["cf.colo.id", "ip.src"]
+      period              = %d
+      requests_per_period = %d
+      mitigation_timeout  = %d
+    }
+  }
+}`, d.Get("zone_id").(string), d.Get("description").(string), expression, action, d.Get("period").(int), d.Get("threshold").(int), timeout)
+}
+
 func flattenRateLimitTrafficMatcher(cfg cloudflare.RateLimitTrafficMatcher) []map[string]interface{} {
 	data := map[string]interface{}{
 		"request":  flattenRateLimitRequestMatcher(cfg.Request),
@@ -386,6 +458,15 @@ func resourceCloudflareRateLimitDelete(ctx context.Context, d *schema.ResourceDa
 	zoneID := d.Get("zone_id").(string)
 	rateLimitId := d.Id()
 
+	if d.Get("read_only").(bool) {
+		tflog.Info(ctx, fmt.Sprintf("removing read-only Rate Limit %s for zone %s from state without deleting it", rateLimitId, zoneID))
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("rate limit %s was removed from state but not deleted, as it is in read-only mode", rateLimitId),
+			Detail:   "Delete the legacy rate limit through the dashboard or API once its replacement ruleset rule is in place:\n\n" + rateLimitEquivalentRulesetConfig(d),
+		}}
+	}
+
 	tflog.Info(ctx, fmt.Sprintf("Deleting Cloudflare Rate Limit: %s for zone: %s", rateLimitId, zoneID))
 
 	err := client.DeleteRateLimit(ctx, zoneID, rateLimitId)
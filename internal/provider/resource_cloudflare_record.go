@@ -2,8 +2,11 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"net/http"
 	"strings"
 	"time"
 
@@ -40,6 +43,56 @@ func resourceCloudflareRecord() *schema.Resource {
 	}
 }
 
+// dnsRecordMetadata carries the `comment` and `tags` attributes which aren't
+// part of the client library's DNSRecord struct yet and are managed through a
+// separate PATCH.
+type dnsRecordMetadata struct {
+	Comment string   `json:"comment"`
+	Tags    []string `json:"tags"`
+}
+
+// setDNSRecordMetadata pushes the configured `comment` and `tags` values for
+// the record. Sending empty values clears any previously set metadata.
+func setDNSRecordMetadata(client *cloudflare.API, zoneID string, d *schema.ResourceData) error {
+	metadata := dnsRecordMetadata{
+		Comment: d.Get("comment").(string),
+		Tags:    []string{},
+	}
+	for _, tag := range d.Get("tags").(*schema.Set).List() {
+		metadata.Tags = append(metadata.Tags, tag.(string))
+	}
+
+	if metadata.Comment == "" && len(metadata.Tags) == 0 && !d.HasChanges("comment", "tags") {
+		return nil
+	}
+
+	_, err := client.Raw(http.MethodPatch, fmt.Sprintf("/zones/%s/dns_records/%s", zoneID, d.Id()), metadata)
+	if err != nil {
+		return fmt.Errorf("failed to set DNS record comment and tags: %w", err)
+	}
+
+	return nil
+}
+
+// readDNSRecordMetadata reads `comment` and `tags` back into state from the
+// raw record payload.
+func readDNSRecordMetadata(client *cloudflare.API, zoneID string, d *schema.ResourceData) error {
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/zones/%s/dns_records/%s", zoneID, d.Id()), nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch DNS record comment and tags: %w", err)
+	}
+
+	var metadata dnsRecordMetadata
+	if err := json.Unmarshal(res, &metadata); err != nil {
+		return fmt.Errorf("failed to decode DNS record comment and tags: %w", err)
+	}
+
+	d.Set("comment", metadata.Comment)
+	d.Set("tags", metadata.Tags)
+
+	return nil
+}
+
 func resourceCloudflareRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
@@ -76,6 +129,10 @@ func resourceCloudflareRecordCreate(ctx context.Context, d *schema.ResourceData,
 			newDataMap[id] = newData
 		}
 
+		if err := validateHTTPSData(newRecord.Type, newDataMap); err != nil {
+			return diag.FromErr(err)
+		}
+
 		newRecord.Data = newDataMap
 	}
 
@@ -171,6 +228,10 @@ func resourceCloudflareRecordCreate(ctx context.Context, d *schema.ResourceData,
 
 		d.SetId(r.Result.ID)
 
+		if err := setDNSRecordMetadata(client, newRecord.ZoneID, d); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		resourceCloudflareRecordRead(ctx, d, meta)
 
 		return nil
@@ -180,9 +241,87 @@ func resourceCloudflareRecordCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(retry)
 	}
 
+	if d.Get("wait_for_propagation").(bool) {
+		if err := waitForDNSRecordPropagation(ctx, d, client, schema.TimeoutCreate); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
+// waitForDNSRecordPropagation queries the zone's authoritative nameservers
+// until the record resolves with the expected content, so dependent resources
+// such as ACME validations don't race the record becoming visible.
+func waitForDNSRecordPropagation(ctx context.Context, d *schema.ResourceData, client *cloudflare.API, timeoutKey string) error {
+	zoneID := d.Get("zone_id").(string)
+
+	record, err := client.DNSRecord(ctx, zoneID, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading DNS record %q: %w", d.Id(), err)
+	}
+
+	zone, err := client.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return fmt.Errorf("error reading zone %q: %w", zoneID, err)
+	}
+
+	if len(zone.NameServers) == 0 {
+		return fmt.Errorf("zone %q has no assigned nameservers to check propagation against", zoneID)
+	}
+
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			dialer := net.Dialer{}
+			return dialer.DialContext(ctx, network, net.JoinHostPort(zone.NameServers[0], "53"))
+		},
+	}
+
+	proxied := record.Proxied != nil && *record.Proxied
+
+	return resource.RetryContext(ctx, d.Timeout(timeoutKey), func() *resource.RetryError {
+		switch record.Type {
+		case "A", "AAAA":
+			addrs, err := resolver.LookupHost(ctx, record.Name)
+			if err != nil {
+				return resource.RetryableError(fmt.Errorf("record %q does not resolve yet: %w", record.Name, err))
+			}
+			// Proxied records resolve to the Cloudflare edge rather than the
+			// configured content, so resolving at all is as far as we can check.
+			if !proxied && !contains(addrs, record.Content) {
+				return resource.RetryableError(fmt.Errorf("record %q does not resolve with the expected content yet", record.Name))
+			}
+		case "CNAME":
+			if proxied {
+				if _, err := resolver.LookupHost(ctx, record.Name); err != nil {
+					return resource.RetryableError(fmt.Errorf("record %q does not resolve yet: %w", record.Name, err))
+				}
+				return nil
+			}
+			cname, err := resolver.LookupCNAME(ctx, record.Name)
+			if err != nil {
+				return resource.RetryableError(fmt.Errorf("record %q does not resolve yet: %w", record.Name, err))
+			}
+			if strings.TrimSuffix(cname, ".") != strings.TrimSuffix(record.Content, ".") {
+				return resource.RetryableError(fmt.Errorf("record %q does not resolve with the expected content yet", record.Name))
+			}
+		case "TXT":
+			values, err := resolver.LookupTXT(ctx, record.Name)
+			if err != nil {
+				return resource.RetryableError(fmt.Errorf("record %q does not resolve yet: %w", record.Name, err))
+			}
+			if !contains(values, strings.Trim(record.Content, "\"")) {
+				return resource.RetryableError(fmt.Errorf("record %q does not resolve with the expected content yet", record.Name))
+			}
+		default:
+			tflog.Info(ctx, fmt.Sprintf("Propagation check not supported for %s records, skipping", record.Type))
+		}
+
+		return nil
+	})
+}
+
 func resourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
@@ -234,6 +373,10 @@ func resourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	d.Set("proxiable", record.Proxiable)
 
+	if err := readDNSRecordMetadata(client, zoneID, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if record.Priority != nil {
 		priority := record.Priority
 		p := *priority
@@ -272,6 +415,10 @@ func resourceCloudflareRecordUpdate(ctx context.Context, d *schema.ResourceData,
 			newDataMap[id] = newData
 		}
 
+		if err := validateHTTPSData(updateRecord.Type, newDataMap); err != nil {
+			return diag.FromErr(err)
+		}
+
 		updateRecord.Data = newDataMap
 	}
 
@@ -305,6 +452,10 @@ func resourceCloudflareRecordUpdate(ctx context.Context, d *schema.ResourceData,
 			return resource.NonRetryableError(fmt.Errorf("failed to create DNS record: %w", err))
 		}
 
+		if err := setDNSRecordMetadata(client, zoneID, d); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
 		resourceCloudflareRecordRead(ctx, d, meta)
 		return nil
 	})
@@ -313,6 +464,12 @@ func resourceCloudflareRecordUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(retry)
 	}
 
+	if d.Get("wait_for_propagation").(bool) {
+		if err := waitForDNSRecordPropagation(ctx, d, client, schema.TimeoutUpdate); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
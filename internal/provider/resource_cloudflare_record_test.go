@@ -779,3 +779,38 @@ resource "cloudflare_record" "%[2]s" {
 	ttl = 300
 }`, zoneID, name, zoneName)
 }
+
+func TestAccCloudflareRecord_CommentAndTags(t *testing.T) {
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigCommentAndTags(zoneID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "managed by terraform"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareRecordConfigCommentAndTags(zoneID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+	zone_id = "%[1]s"
+	name = "%[2]s"
+	value = "192.168.0.10"
+	type = "A"
+	ttl = 3600
+	comment = "managed by terraform"
+	tags = ["team:dns", "env:test"]
+}`, zoneID, rnd)
+}
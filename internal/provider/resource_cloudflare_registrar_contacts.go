@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// registrarContactTLDRequiredFields lists contact fields individual TLD
+// registries require beyond the base set.
+var registrarContactTLDRequiredFields = map[string][]string{
+	"au": {"organization"},
+	"ca": {"state"},
+	"uk": {"organization"},
+	"us": {"state", "zip"},
+}
+
+func resourceCloudflareRegistrarContacts() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareRegistrarContactsSchema(),
+		CreateContext: resourceCloudflareRegistrarContactsCreate,
+		ReadContext:   resourceCloudflareRegistrarContactsRead,
+		UpdateContext: resourceCloudflareRegistrarContactsCreate,
+		DeleteContext: resourceCloudflareRegistrarContactsDelete,
+		CustomizeDiff: resourceCloudflareRegistrarContactsDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareRegistrarContactsImport,
+		},
+		Description: "Provides a resource which manages the registrant, administrative and technical contacts of a Cloudflare Registrar domain.",
+	}
+}
+
+// resourceCloudflareRegistrarContactsDiff enforces the contact fields the
+// registry of the domain's TLD requires beyond the base set.
+func resourceCloudflareRegistrarContactsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	domain := strings.ToLower(d.Get("domain").(string))
+	labels := strings.Split(domain, ".")
+	tld := labels[len(labels)-1]
+
+	required, ok := registrarContactTLDRequiredFields[tld]
+	if !ok {
+		return nil
+	}
+
+	for _, contactType := range []string{"registrant", "admin", "tech"} {
+		contacts := d.Get(contactType).([]interface{})
+		if len(contacts) == 0 || contacts[0] == nil {
+			continue
+		}
+		contact := contacts[0].(map[string]interface{})
+		for _, field := range required {
+			if contact[field].(string) == "" {
+				return fmt.Errorf("the .%s registry requires %q to be set on the %s contact", tld, field, contactType)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandRegistrarContact(raw []interface{}) *cloudflare.RegistrantContact {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	cfg := raw[0].(map[string]interface{})
+	return &cloudflare.RegistrantContact{
+		FirstName:    cfg["first_name"].(string),
+		LastName:     cfg["last_name"].(string),
+		Organization: cfg["organization"].(string),
+		Address:      cfg["address"].(string),
+		Address2:     cfg["address2"].(string),
+		City:         cfg["city"].(string),
+		State:        cfg["state"].(string),
+		Zip:          cfg["zip"].(string),
+		Country:      cfg["country"].(string),
+		Phone:        cfg["phone"].(string),
+		Email:        cfg["email"].(string),
+		Fax:          cfg["fax"].(string),
+	}
+}
+
+func flattenRegistrarContact(contact cloudflare.RegistrantContact) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"first_name":   contact.FirstName,
+		"last_name":    contact.LastName,
+		"organization": contact.Organization,
+		"address":      contact.Address,
+		"address2":     contact.Address2,
+		"city":         contact.City,
+		"state":        contact.State,
+		"zip":          contact.Zip,
+		"country":      contact.Country,
+		"phone":        contact.Phone,
+		"email":        contact.Email,
+		"fax":          contact.Fax,
+	}}
+}
+
+func resourceCloudflareRegistrarContactsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	domain := d.Get("domain").(string)
+
+	registrant := expandRegistrarContact(d.Get("registrant").([]interface{}))
+
+	// the administrative and technical contacts fall back to the registrant
+	admin := expandRegistrarContact(d.Get("admin").([]interface{}))
+	if admin == nil {
+		admin = registrant
+	}
+	tech := expandRegistrarContact(d.Get("tech").([]interface{}))
+	if tech == nil {
+		tech = registrant
+	}
+
+	_, err := client.Raw(http.MethodPut, fmt.Sprintf("/accounts/%s/registrar/domains/%s", accountID, domain), map[string]interface{}{
+		"registrant_contact":    registrant,
+		"administrator_contact": admin,
+		"technical_contact":     tech,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating contacts of registrar domain %q: %w", domain, err))
+	}
+
+	d.SetId(domain)
+
+	return resourceCloudflareRegistrarContactsRead(ctx, d, meta)
+}
+
+func resourceCloudflareRegistrarContactsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	domain, err := client.RegistrarDomain(ctx, accountID, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading registrar domain %q: %w", d.Id(), err))
+	}
+
+	d.Set("domain", d.Id())
+	// the API only returns the registrant contact; the administrative and
+	// technical contacts are left as configured
+	if domain.RegistrantContact.Email != "" {
+		d.Set("registrant", flattenRegistrarContact(domain.RegistrantContact))
+	}
+
+	return nil
+}
+
+func resourceCloudflareRegistrarContactsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// a registrar domain always has contacts; there is nothing to delete
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Contacts of registrar domain %q removed from state but not deleted", d.Id()),
+			Detail:   "A registered domain always has contacts. The current contacts remain on the domain and can be re-imported.",
+		},
+	}
+}
+
+func resourceCloudflareRegistrarContactsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/domain\"", d.Id())
+	}
+
+	d.Set("account_id", attributes[0])
+	d.SetId(attributes[1])
+
+	resourceCloudflareRegistrarContactsRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareRegistrarContacts(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_registrar_contacts.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckDomain(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRegistrarContactsConfig(rnd, accountID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "domain", domain),
+					resource.TestCheckResourceAttr(name, "registrant.0.first_name", "John"),
+					resource.TestCheckResourceAttr(name, "registrant.0.country", "US"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRegistrarContactsConfig(name, accountID, domain string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_registrar_contacts" "%[1]s" {
+		account_id = "%[2]s"
+		domain     = "%[3]s"
+
+		registrant {
+			first_name   = "John"
+			last_name    = "Appleseed"
+			organization = "Example Corp"
+			address      = "123 Sesame St."
+			city         = "Austin"
+			state        = "TX"
+			zip          = "78701"
+			country      = "US"
+			phone        = "+1.5125551212"
+			email        = "registrant@example.com"
+		}
+	}`, name, accountID, domain)
+}
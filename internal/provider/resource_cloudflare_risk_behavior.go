@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type riskBehavior struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enabled     bool   `json:"enabled"`
+	RiskLevel   string `json:"risk_level"`
+}
+
+type riskBehaviors struct {
+	Behaviors map[string]riskBehavior `json:"behaviors"`
+}
+
+func resourceCloudflareRiskBehavior() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareRiskBehaviorSchema(),
+		CreateContext: resourceCloudflareRiskBehaviorUpdate,
+		ReadContext:   resourceCloudflareRiskBehaviorRead,
+		UpdateContext: resourceCloudflareRiskBehaviorUpdate,
+		DeleteContext: resourceCloudflareRiskBehaviorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareRiskBehaviorImport,
+		},
+	}
+}
+
+func riskBehaviorsFromAPI(client *cloudflare.API, accountID string) (riskBehaviors, error) {
+	var behaviors riskBehaviors
+
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/accounts/%s/zt_risk_scoring/behaviors", accountID), nil)
+	if err != nil {
+		return behaviors, err
+	}
+
+	err = json.Unmarshal(res, &behaviors)
+	return behaviors, err
+}
+
+func resourceCloudflareRiskBehaviorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	behaviors, err := riskBehaviorsFromAPI(client, accountID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to fetch risk behaviors: %w", err))
+	}
+
+	// Only track the behaviors present in the configuration so that newly
+	// introduced behavior types don't create permanent diffs.
+	configured := map[string]bool{}
+	for _, item := range d.Get("behavior").(*schema.Set).List() {
+		configured[item.(map[string]interface{})["name"].(string)] = true
+	}
+
+	behaviorDetails := []interface{}{}
+	for name, behavior := range behaviors.Behaviors {
+		if len(configured) > 0 && !configured[name] {
+			continue
+		}
+
+		behaviorDetails = append(behaviorDetails, map[string]interface{}{
+			"name":       name,
+			"enabled":    behavior.Enabled,
+			"risk_level": behavior.RiskLevel,
+		})
+	}
+
+	d.SetId(accountID)
+	d.Set("behavior", behaviorDetails)
+
+	return nil
+}
+
+func resourceCloudflareRiskBehaviorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	behaviors := riskBehaviors{Behaviors: map[string]riskBehavior{}}
+	for _, item := range d.Get("behavior").(*schema.Set).List() {
+		behavior := item.(map[string]interface{})
+		behaviors.Behaviors[behavior["name"].(string)] = riskBehavior{
+			Enabled:   behavior["enabled"].(bool),
+			RiskLevel: behavior["risk_level"].(string),
+		}
+	}
+
+	_, err := client.Raw(http.MethodPut, fmt.Sprintf("/accounts/%s/zt_risk_scoring/behaviors", accountID), behaviors)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update risk behaviors: %w", err))
+	}
+
+	d.SetId(accountID)
+
+	return resourceCloudflareRiskBehaviorRead(ctx, d, meta)
+}
+
+func resourceCloudflareRiskBehaviorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	// Behaviors cannot be deleted, only disabled. Turn off the behaviors
+	// managed by this resource and leave the rest untouched.
+	behaviors := riskBehaviors{Behaviors: map[string]riskBehavior{}}
+	for _, item := range d.Get("behavior").(*schema.Set).List() {
+		behavior := item.(map[string]interface{})
+		behaviors.Behaviors[behavior["name"].(string)] = riskBehavior{
+			Enabled:   false,
+			RiskLevel: behavior["risk_level"].(string),
+		}
+	}
+
+	_, err := client.Raw(http.MethodPut, fmt.Sprintf("/accounts/%s/zt_risk_scoring/behaviors", accountID), behaviors)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to disable risk behaviors: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareRiskBehaviorImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("account_id", d.Id())
+
+	resourceCloudflareRiskBehaviorRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareRiskBehavior_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_risk_behavior.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRiskBehaviorConfig(rnd, accountID, "high"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "behavior.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "behavior.*", map[string]string{
+						"name":       "imp_travel",
+						"risk_level": "high",
+					}),
+				),
+			},
+			{
+				Config: testAccCloudflareRiskBehaviorConfig(rnd, accountID, "medium"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(name, "behavior.*", map[string]string{
+						"name":       "imp_travel",
+						"risk_level": "medium",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRiskBehaviorConfig(rnd, accountID, riskLevel string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_risk_behavior" "%[1]s" {
+  account_id = "%[2]s"
+
+  behavior {
+    name       = "imp_travel"
+    enabled    = true
+    risk_level = "%[3]s"
+  }
+}`, rnd, accountID, riskLevel)
+}
@@ -31,6 +31,7 @@ func resourceCloudflareRuleset() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareRulesetImport,
 		},
+		CustomizeDiff: resourceCloudflareRulesetRatelimitDiff,
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -50,10 +51,99 @@ basic syntax.
 	}
 }
 
+// rulesetRatelimitCharacteristicTiers maps each characteristic the rate
+// limiting engine accepts to the entitlement tier it requires. Invalid values
+// are rejected by the API with an unhelpful 400, so they are checked at plan
+// time instead.
+var rulesetRatelimitCharacteristicTiers = map[string]string{
+	"cf.colo.id":                 "standard",
+	"ip.src":                     "standard",
+	"cf.unique_visitor_id":       "advanced",
+	"ip.geoip.asnum":             "advanced",
+	"ip.geoip.country":           "advanced",
+	"cf.bot_management.ja3_hash": "advanced",
+}
+
+// rulesetRatelimitKeyedCharacteristics matches the per-key characteristics
+// available with the advanced rate limiting entitlement.
+var rulesetRatelimitKeyedCharacteristics = []*regexp.Regexp{
+	regexp.MustCompile(`^http\.request\.headers\["[^"]+"\]$`),
+	regexp.MustCompile(`^http\.request\.cookies\["[^"]+"\]$`),
+	regexp.MustCompile(`^http\.request\.uri\.args\["[^"]+"\]$`),
+}
+
+func rulesetRatelimitCharacteristicsByTier(tier string) []string {
+	var characteristics []string
+	for characteristic, characteristicTier := range rulesetRatelimitCharacteristicTiers {
+		if characteristicTier == tier {
+			characteristics = append(characteristics, characteristic)
+		}
+	}
+	sort.Strings(characteristics)
+	return characteristics
+}
+
+func resourceCloudflareRulesetRatelimitDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i, rawRule := range d.Get("rules").([]interface{}) {
+		rule, ok := rawRule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, rawRatelimit := range rule["ratelimit"].([]interface{}) {
+			ratelimit, ok := rawRatelimit.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			characteristics := ratelimit["characteristics"].(*schema.Set)
+			if characteristics.Len() == 0 {
+				continue
+			}
+
+			for _, rawCharacteristic := range characteristics.List() {
+				characteristic := rawCharacteristic.(string)
+				if _, known := rulesetRatelimitCharacteristicTiers[characteristic]; known {
+					continue
+				}
+
+				keyed := false
+				for _, pattern := range rulesetRatelimitKeyedCharacteristics {
+					if pattern.MatchString(characteristic) {
+						keyed = true
+						break
+					}
+				}
+				if keyed {
+					continue
+				}
+
+				return fmt.Errorf(
+					"rules.%d: ratelimit characteristic %q is not supported; standard rate limiting supports %q, advanced rate limiting additionally supports %q and http.request.headers[\"...\"], http.request.cookies[\"...\"] and http.request.uri.args[\"...\"] keys",
+					i, characteristic,
+					rulesetRatelimitCharacteristicsByTier("standard"),
+					rulesetRatelimitCharacteristicsByTier("advanced"),
+				)
+			}
+
+			if !characteristics.Contains("cf.colo.id") {
+				return fmt.Errorf("rules.%d: ratelimit characteristics must include \"cf.colo.id\"", i)
+			}
+
+			if characteristics.Contains("ip.src") && characteristics.Contains("cf.unique_visitor_id") {
+				return fmt.Errorf("rules.%d: ratelimit characteristics \"ip.src\" and \"cf.unique_visitor_id\" cannot be combined; \"cf.unique_visitor_id\" (advanced rate limiting) already identifies visitors behind NAT", i)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 	zoneID := d.Get("zone_id").(string)
+	defer lockZoneWrites(client, zoneID)()
 	rulesetPhase := d.Get("phase").(string)
 
 	var ruleset cloudflare.Ruleset
@@ -170,7 +260,7 @@ func resourceCloudflareRulesetRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("name", ruleset.Name)
 	d.Set("description", ruleset.Description)
 
-	if err := d.Set("rules", buildStateFromRulesetRules(ruleset.Rules)); err != nil {
+	if err := d.Set("rules", buildStateFromRulesetRules(d, ruleset.Rules)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -181,6 +271,7 @@ func resourceCloudflareRulesetUpdate(ctx context.Context, d *schema.ResourceData
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 	zoneID := d.Get("zone_id").(string)
+	defer lockZoneWrites(client, zoneID)()
 
 	rules, err := buildRulesetRulesFromResource(d)
 	if err != nil {
@@ -205,6 +296,7 @@ func resourceCloudflareRulesetDelete(ctx context.Context, d *schema.ResourceData
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 	zoneID := d.Get("zone_id").(string)
+	defer lockZoneWrites(client, zoneID)()
 	var err error
 
 	if accountID != "" {
@@ -222,9 +314,9 @@ func resourceCloudflareRulesetDelete(ctx context.Context, d *schema.ResourceData
 
 // buildStateFromRulesetRules receives the current ruleset rules and returns an
 // interface for the state file.
-func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
+func buildStateFromRulesetRules(d *schema.ResourceData, rules []cloudflare.RulesetRule) interface{} {
 	var rulesData []map[string]interface{}
-	for _, r := range rules {
+	for ruleIndex, r := range rules {
 		rule := map[string]interface{}{
 			"id":         r.ID,
 			"expression": r.Expression,
@@ -257,6 +349,7 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 				fromListFields         []map[string]interface{}
 			)
 			actionParameterRules := make(map[string]string)
+			matchedDataInOverrides := false
 
 			if !reflect.ValueOf(r.ActionParameters.Overrides).IsNil() {
 				for _, overrideRule := range r.ActionParameters.Overrides.Rules {
@@ -277,12 +370,26 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 					})
 				}
 
-				overrides = append(overrides, map[string]interface{}{
+				override := map[string]interface{}{
 					"categories": categoryBasedOverrides,
 					"rules":      idBasedOverrides,
 					"status":     apiEnabledToStatusFieldConversion(r.ActionParameters.Overrides.Enabled),
 					"action":     r.ActionParameters.Overrides.Action,
-				})
+				}
+
+				// The API reports payload logging in a single location so mirror
+				// whichever placement the configuration used, otherwise an
+				// override-level `matched_data` would flap between the two.
+				if !reflect.ValueOf(r.ActionParameters.MatchedData).IsNil() {
+					if _, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.overrides.0.matched_data", ruleIndex)); ok {
+						override["matched_data"] = []map[string]interface{}{{
+							"public_key": r.ActionParameters.MatchedData.PublicKey,
+						}}
+						matchedDataInOverrides = true
+					}
+				}
+
+				overrides = append(overrides, override)
 			}
 
 			if !reflect.ValueOf(r.ActionParameters.Headers).IsNil() {
@@ -331,7 +438,7 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 				})
 			}
 
-			if !reflect.ValueOf(r.ActionParameters.MatchedData).IsNil() {
+			if !reflect.ValueOf(r.ActionParameters.MatchedData).IsNil() && !matchedDataInOverrides {
 				matchedData = append(matchedData, map[string]interface{}{
 					"public_key": r.ActionParameters.MatchedData.PublicKey,
 				})
@@ -698,6 +805,17 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 									rules = append(rules, ruleOverride)
 								}
 							}
+
+							// Payload logging for the executed managed ruleset. This maps
+							// onto the same API field as the `action_parameters` level
+							// `matched_data` but takes precedence over it.
+							if val, ok := overrideParamValue.(map[string]interface{})["matched_data"]; ok {
+								for i := range val.([]interface{}) {
+									rule.ActionParameters.MatchedData = &cloudflare.RulesetRuleActionParametersMatchedData{
+										PublicKey: val.([]interface{})[i].(map[string]interface{})["public_key"].(string),
+									}
+								}
+							}
 						}
 
 						if len(categories) > 0 || len(rules) > 0 {
@@ -710,6 +828,13 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 						}
 
 					case "matched_data":
+						// An override-level `matched_data` takes precedence over the
+						// `action_parameters` level one, regardless of the order the
+						// blocks are expanded in.
+						if rule.ActionParameters.MatchedData != nil {
+							continue
+						}
+
 						for i := range pValue.([]interface{}) {
 							rule.ActionParameters.MatchedData = &cloudflare.RulesetRuleActionParametersMatchedData{
 								PublicKey: pValue.([]interface{})[i].(map[string]interface{})["public_key"].(string),
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareSecondaryDNSACL() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareSecondaryDNSACLCreate,
+		ReadContext:   resourceCloudflareSecondaryDNSACLRead,
+		UpdateContext: resourceCloudflareSecondaryDNSACLUpdate,
+		DeleteContext: resourceCloudflareSecondaryDNSACLDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareSecondaryDNSACLImport,
+		},
+		Schema: resourceCloudflareSecondaryDNSACLSchema(),
+	}
+}
+
+func resourceCloudflareSecondaryDNSACLSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+
+		"name": {
+			Description: "The name of the ACL.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+
+		"ip_range": {
+			Description:  "The IP range nameservers performing outgoing zone transfers (AXFR/IXFR) and receiving NOTIFYs must fall into. CIDR sizes are limited to /24 for IPv4 and /64 for IPv6.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsCIDR,
+		},
+	}
+}
+
+// secondaryDNSACL is the API representation of a secondary DNS ACL, which the
+// pinned client does not yet model.
+type secondaryDNSACL struct {
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name"`
+	IPRange string `json:"ip_range"`
+}
+
+func secondaryDNSACLEndpoint(accountID, aclID string) string {
+	if aclID == "" {
+		return fmt.Sprintf("/accounts/%s/secondary_dns/acls", accountID)
+	}
+	return fmt.Sprintf("/accounts/%s/secondary_dns/acls/%s", accountID, aclID)
+}
+
+func resourceCloudflareSecondaryDNSACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	acl := secondaryDNSACL{
+		Name:    d.Get("name").(string),
+		IPRange: d.Get("ip_range").(string),
+	}
+
+	res, err := client.Raw(http.MethodPost, secondaryDNSACLEndpoint(accountID, ""), acl)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating secondary DNS ACL: %w", err))
+	}
+
+	if err := json.Unmarshal(res, &acl); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding secondary DNS ACL: %w", err))
+	}
+
+	d.SetId(acl.ID)
+
+	return resourceCloudflareSecondaryDNSACLRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodGet, secondaryDNSACLEndpoint(accountID, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			tflog.Info(ctx, fmt.Sprintf("Secondary DNS ACL %s not found", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading secondary DNS ACL %q: %w", d.Id(), err))
+	}
+
+	var acl secondaryDNSACL
+	if err := json.Unmarshal(res, &acl); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding secondary DNS ACL %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", acl.Name)
+	d.Set("ip_range", acl.IPRange)
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	acl := secondaryDNSACL{
+		ID:      d.Id(),
+		Name:    d.Get("name").(string),
+		IPRange: d.Get("ip_range").(string),
+	}
+
+	if _, err := client.Raw(http.MethodPut, secondaryDNSACLEndpoint(accountID, d.Id()), acl); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating secondary DNS ACL %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareSecondaryDNSACLRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting secondary DNS ACL %s", d.Id()))
+
+	if _, err := client.Raw(http.MethodDelete, secondaryDNSACLEndpoint(accountID, d.Id()), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting secondary DNS ACL %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSACLImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (%q) specified, should be in format \"accountID/aclID\"", d.Id())
+	}
+
+	d.Set("account_id", attributes[0])
+	d.SetId(attributes[1])
+
+	resourceCloudflareSecondaryDNSACLRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
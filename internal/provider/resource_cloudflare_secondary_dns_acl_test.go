@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareSecondaryDNSACL(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_secondary_dns_acl.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSecondaryDNSACLConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "ip_range", "192.0.2.53/28"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareSecondaryDNSACLConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_secondary_dns_acl" "%[1]s" {
+		account_id = "%[2]s"
+		name       = "%[1]s"
+		ip_range   = "192.0.2.53/28"
+	}`, name, accountID)
+}
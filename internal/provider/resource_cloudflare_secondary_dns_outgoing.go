@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareSecondaryDNSOutgoing() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareSecondaryDNSOutgoingCreate,
+		ReadContext:   resourceCloudflareSecondaryDNSOutgoingRead,
+		UpdateContext: resourceCloudflareSecondaryDNSOutgoingUpdate,
+		DeleteContext: resourceCloudflareSecondaryDNSOutgoingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: resourceCloudflareSecondaryDNSOutgoingSchema(),
+	}
+}
+
+func resourceCloudflareSecondaryDNSOutgoingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+
+		"name": {
+			Description: "The name of the zone to transfer out.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+
+		"peers": {
+			Description: "A list of peer IDs NOTIFYs are sent to and outgoing zone transfers (AXFR/IXFR) are allowed from.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+
+		"enabled": {
+			Description: "Whether outgoing zone transfers are enabled for the zone.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+
+		"soa_serial": {
+			Description: "The serial number of the zone's SOA record at the last NOTIFY.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+
+		"checked_time": {
+			Description: "Timestamp of the last check of the zone against the peers.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+
+		"last_transferred_time": {
+			Description: "Timestamp of the last successful outgoing zone transfer.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+// secondaryDNSOutgoing is the API representation of the outgoing zone
+// transfer settings of a zone, which the pinned client does not yet model.
+type secondaryDNSOutgoing struct {
+	ID                  string   `json:"id,omitempty"`
+	Name                string   `json:"name"`
+	Peers               []string `json:"peers"`
+	SoaSerial           int      `json:"soa_serial,omitempty"`
+	CheckedTime         string   `json:"checked_time,omitempty"`
+	LastTransferredTime string   `json:"last_transferred_time,omitempty"`
+}
+
+func secondaryDNSOutgoingFromResource(d *schema.ResourceData) secondaryDNSOutgoing {
+	return secondaryDNSOutgoing{
+		Name:  d.Get("name").(string),
+		Peers: expandInterfaceToStringList(d.Get("peers").(*schema.Set).List()),
+	}
+}
+
+func setSecondaryDNSOutgoingEnabled(client *cloudflare.API, zoneID string, enabled bool) error {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+	_, err := client.Raw(http.MethodPost, fmt.Sprintf("/zones/%s/secondary_dns/outgoing/%s", zoneID, action), nil)
+	return err
+}
+
+func resourceCloudflareSecondaryDNSOutgoingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	_, err := client.Raw(http.MethodPost, fmt.Sprintf("/zones/%s/secondary_dns/outgoing", zoneID), secondaryDNSOutgoingFromResource(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating outgoing zone transfer settings for zone %q: %w", zoneID, err))
+	}
+
+	if err := setSecondaryDNSOutgoingEnabled(client, zoneID, d.Get("enabled").(bool)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting outgoing zone transfer status for zone %q: %w", zoneID, err))
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareSecondaryDNSOutgoingRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSOutgoingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Id()
+
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/zones/%s/secondary_dns/outgoing", zoneID), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			tflog.Info(ctx, fmt.Sprintf("Outgoing zone transfer settings for zone %s not found", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading outgoing zone transfer settings for zone %q: %w", zoneID, err))
+	}
+
+	var outgoing secondaryDNSOutgoing
+	if err := json.Unmarshal(res, &outgoing); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling outgoing zone transfer settings: %w", err))
+	}
+
+	// the settings endpoint does not report whether transfers are currently
+	// enabled, so `enabled` is left as configured
+	d.Set("zone_id", zoneID)
+	d.Set("name", outgoing.Name)
+	d.Set("peers", expandStringListToSet(outgoing.Peers))
+	d.Set("soa_serial", outgoing.SoaSerial)
+	d.Set("checked_time", outgoing.CheckedTime)
+	d.Set("last_transferred_time", outgoing.LastTransferredTime)
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSOutgoingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Id()
+
+	if _, err := client.Raw(http.MethodPut, fmt.Sprintf("/zones/%s/secondary_dns/outgoing", zoneID), secondaryDNSOutgoingFromResource(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating outgoing zone transfer settings for zone %q: %w", zoneID, err))
+	}
+
+	if d.HasChange("enabled") {
+		if err := setSecondaryDNSOutgoingEnabled(client, zoneID, d.Get("enabled").(bool)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting outgoing zone transfer status for zone %q: %w", zoneID, err))
+		}
+	}
+
+	return resourceCloudflareSecondaryDNSOutgoingRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSOutgoingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Id()
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting outgoing zone transfer settings for zone %s", zoneID))
+
+	if _, err := client.Raw(http.MethodDelete, fmt.Sprintf("/zones/%s/secondary_dns/outgoing", zoneID), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting outgoing zone transfer settings for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
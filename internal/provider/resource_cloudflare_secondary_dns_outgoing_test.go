@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareSecondaryDNSOutgoing(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_secondary_dns_outgoing.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDomain(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSecondaryDNSOutgoingConfig(rnd, zoneID, domain, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "name", domain),
+					resource.TestCheckResourceAttr(name, "peers.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareSecondaryDNSOutgoingConfig(name, zoneID, domain, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_secondary_dns_peer" "%[1]s" {
+		account_id = "%[4]s"
+		name       = "%[1]s"
+		ip         = "192.0.2.53"
+		port       = 53
+	}
+
+	resource "cloudflare_secondary_dns_outgoing" "%[1]s" {
+		zone_id = "%[2]s"
+		name    = "%[3]s"
+		peers   = [cloudflare_secondary_dns_peer.%[1]s.id]
+	}`, name, zoneID, domain, accountID)
+}
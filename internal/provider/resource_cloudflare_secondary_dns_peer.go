@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareSecondaryDNSPeer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareSecondaryDNSPeerCreate,
+		ReadContext:   resourceCloudflareSecondaryDNSPeerRead,
+		UpdateContext: resourceCloudflareSecondaryDNSPeerUpdate,
+		DeleteContext: resourceCloudflareSecondaryDNSPeerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareSecondaryDNSPeerImport,
+		},
+		Schema: resourceCloudflareSecondaryDNSPeerSchema(),
+	}
+}
+
+func resourceCloudflareSecondaryDNSPeerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+
+		"name": {
+			Description: "The name of the peer.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+
+		"ip": {
+			Description:  "The IP address of the primary nameserver to transfer zones from or send NOTIFYs to.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+
+		"port": {
+			Description:  "The port of the primary nameserver.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      53,
+			ValidateFunc: validation.IsPortNumber,
+		},
+
+		"ixfr_enable": {
+			Description: "Whether IXFR (incremental zone transfer) is enabled for the peer. Only applies to incoming zone transfers.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+
+		"tsig_id": {
+			Description:  "The ID of a TSIG to authenticate transfers with the peer.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9a-f]{32}$"), "tsig_id must be a 32-character hexadecimal TSIG identifier"),
+		},
+	}
+}
+
+func secondaryDNSPeerFromResource(d *schema.ResourceData) cloudflare.SecondaryDNSPrimary {
+	return cloudflare.SecondaryDNSPrimary{
+		ID:         d.Id(),
+		Name:       d.Get("name").(string),
+		IP:         d.Get("ip").(string),
+		Port:       d.Get("port").(int),
+		IxfrEnable: d.Get("ixfr_enable").(bool),
+		TsigID:     d.Get("tsig_id").(string),
+	}
+}
+
+func resourceCloudflareSecondaryDNSPeerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	peer, err := client.CreateSecondaryDNSPrimary(ctx, accountID, secondaryDNSPeerFromResource(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating secondary DNS peer: %w", err))
+	}
+
+	d.SetId(peer.ID)
+
+	return resourceCloudflareSecondaryDNSPeerRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSPeerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	peer, err := client.GetSecondaryDNSPrimary(ctx, accountID, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			tflog.Info(ctx, fmt.Sprintf("Secondary DNS peer %s not found", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading secondary DNS peer %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", peer.Name)
+	d.Set("ip", peer.IP)
+	d.Set("port", peer.Port)
+	d.Set("ixfr_enable", peer.IxfrEnable)
+	d.Set("tsig_id", peer.TsigID)
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSPeerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	if _, err := client.UpdateSecondaryDNSPrimary(ctx, accountID, secondaryDNSPeerFromResource(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating secondary DNS peer %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareSecondaryDNSPeerRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSPeerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting secondary DNS peer %s", d.Id()))
+
+	if err := client.DeleteSecondaryDNSPrimary(ctx, accountID, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting secondary DNS peer %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSPeerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (%q) specified, should be in format \"accountID/peerID\"", d.Id())
+	}
+
+	d.Set("account_id", attributes[0])
+	d.SetId(attributes[1])
+
+	resourceCloudflareSecondaryDNSPeerRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
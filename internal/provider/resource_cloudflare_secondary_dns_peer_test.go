@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareSecondaryDNSPeer(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_secondary_dns_peer.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSecondaryDNSPeerConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "ip", "192.0.2.53"),
+					resource.TestCheckResourceAttr(name, "port", "53"),
+					resource.TestCheckResourceAttr(name, "ixfr_enable", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareSecondaryDNSPeerConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_secondary_dns_peer" "%[1]s" {
+		account_id = "%[2]s"
+		name       = "%[1]s"
+		ip         = "192.0.2.53"
+		port       = 53
+	}`, name, accountID)
+}
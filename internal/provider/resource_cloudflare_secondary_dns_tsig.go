@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareSecondaryDNSTSIG() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareSecondaryDNSTSIGCreate,
+		ReadContext:   resourceCloudflareSecondaryDNSTSIGRead,
+		UpdateContext: resourceCloudflareSecondaryDNSTSIGUpdate,
+		DeleteContext: resourceCloudflareSecondaryDNSTSIGDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareSecondaryDNSTSIGImport,
+		},
+		Schema: resourceCloudflareSecondaryDNSTSIGSchema(),
+	}
+}
+
+func resourceCloudflareSecondaryDNSTSIGSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+
+		"name": {
+			Description: "The name of the TSIG key.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+
+		"secret": {
+			Description: "The TSIG secret.",
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+		},
+
+		"algo": {
+			Description:  "The hashing algorithm of the TSIG.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"hmac-md5.sig-alg.reg.int.", "hmac-sha1.", "hmac-sha256.", "hmac-sha384.", "hmac-sha512."}, false),
+		},
+	}
+}
+
+func secondaryDNSTSIGFromResource(d *schema.ResourceData) cloudflare.SecondaryDNSTSIG {
+	return cloudflare.SecondaryDNSTSIG{
+		ID:     d.Id(),
+		Name:   d.Get("name").(string),
+		Secret: d.Get("secret").(string),
+		Algo:   d.Get("algo").(string),
+	}
+}
+
+func resourceCloudflareSecondaryDNSTSIGCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tsig, err := client.CreateSecondaryDNSTSIG(ctx, accountID, secondaryDNSTSIGFromResource(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating secondary DNS TSIG: %w", err))
+	}
+
+	d.SetId(tsig.ID)
+
+	return resourceCloudflareSecondaryDNSTSIGRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSTSIGRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tsig, err := client.GetSecondaryDNSTSIG(ctx, accountID, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			tflog.Info(ctx, fmt.Sprintf("Secondary DNS TSIG %s not found", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading secondary DNS TSIG %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", tsig.Name)
+	d.Set("secret", tsig.Secret)
+	d.Set("algo", tsig.Algo)
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSTSIGUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	if _, err := client.UpdateSecondaryDNSTSIG(ctx, accountID, secondaryDNSTSIGFromResource(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating secondary DNS TSIG %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareSecondaryDNSTSIGRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSTSIGDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting secondary DNS TSIG %s", d.Id()))
+
+	if err := client.DeleteSecondaryDNSTSIG(ctx, accountID, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting secondary DNS TSIG %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSTSIGImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (%q) specified, should be in format \"accountID/tsigID\"", d.Id())
+	}
+
+	d.Set("account_id", attributes[0])
+	d.SetId(attributes[1])
+
+	resourceCloudflareSecondaryDNSTSIGRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
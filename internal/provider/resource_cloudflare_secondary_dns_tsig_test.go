@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareSecondaryDNSTSIG(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_secondary_dns_tsig.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSecondaryDNSTSIGConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd+"."),
+					resource.TestCheckResourceAttr(name, "algo", "hmac-sha512."),
+					resource.TestCheckResourceAttrSet(name, "secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareSecondaryDNSTSIGConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_secondary_dns_tsig" "%[1]s" {
+		account_id = "%[2]s"
+		name       = "%[1]s."
+		secret     = "caf79a7804b04337c9c66ccd7bef9190a1e1679b5dd03d8aa10f7ad45e1a9dab92b417896c15d4d007c7c14194538d2a5d0feffdecc5a7f0e1c570cfa700837c"
+		algo       = "hmac-sha512."
+	}`, name, accountID)
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareSecondaryDNSZone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareSecondaryDNSZoneCreate,
+		ReadContext:   resourceCloudflareSecondaryDNSZoneRead,
+		UpdateContext: resourceCloudflareSecondaryDNSZoneUpdate,
+		DeleteContext: resourceCloudflareSecondaryDNSZoneDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: resourceCloudflareSecondaryDNSZoneSchema(),
+	}
+}
+
+func resourceCloudflareSecondaryDNSZoneSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+
+		"name": {
+			Description: "The name of the secondary zone.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+
+		"primaries": {
+			Description: "A list of peer IDs to transfer the zone from, in failover order.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+
+		"auto_refresh_seconds": {
+			Description:  "How often the zone should be transferred from the primaries, in seconds.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      86400,
+			ValidateFunc: validation.IntAtLeast(60),
+		},
+
+		"soa_serial": {
+			Description: "The serial number of the zone's SOA record after the last transfer.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+
+		"checked_time": {
+			Description: "RFC3339 timestamp of the last successful check against the primaries.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+
+		"created_time": {
+			Description: "RFC3339 timestamp of when the secondary zone was configured.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+
+		"modified_time": {
+			Description: "RFC3339 timestamp of when the secondary zone was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func secondaryDNSZoneFromResource(d *schema.ResourceData) cloudflare.SecondaryDNSZone {
+	return cloudflare.SecondaryDNSZone{
+		ID:                 d.Get("zone_id").(string),
+		Name:               d.Get("name").(string),
+		Primaries:          expandInterfaceToStringList(d.Get("primaries").(*schema.Set).List()),
+		AutoRefreshSeconds: d.Get("auto_refresh_seconds").(int),
+	}
+}
+
+func resourceCloudflareSecondaryDNSZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	zone, err := client.CreateSecondaryDNSZone(ctx, zoneID, secondaryDNSZoneFromResource(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating secondary DNS zone %q: %w", zoneID, err))
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Created secondary DNS zone %s", zone.ID))
+	d.SetId(zoneID)
+
+	return resourceCloudflareSecondaryDNSZoneRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Id()
+
+	zone, err := client.GetSecondaryDNSZone(ctx, zoneID)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			tflog.Info(ctx, fmt.Sprintf("Secondary DNS zone %s not found", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading secondary DNS zone %q: %w", zoneID, err))
+	}
+
+	d.Set("zone_id", zoneID)
+	d.Set("name", zone.Name)
+	d.Set("primaries", expandStringListToSet(zone.Primaries))
+	d.Set("auto_refresh_seconds", zone.AutoRefreshSeconds)
+	d.Set("soa_serial", zone.SoaSerial)
+	d.Set("checked_time", zone.CheckedTime.Format(time.RFC3339))
+	d.Set("created_time", zone.CreatedTime.Format(time.RFC3339))
+	d.Set("modified_time", zone.ModifiedTime.Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceCloudflareSecondaryDNSZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Id()
+
+	if _, err := client.UpdateSecondaryDNSZone(ctx, zoneID, secondaryDNSZoneFromResource(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating secondary DNS zone %q: %w", zoneID, err))
+	}
+
+	return resourceCloudflareSecondaryDNSZoneRead(ctx, d, meta)
+}
+
+func resourceCloudflareSecondaryDNSZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Id()
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting secondary DNS zone %s", zoneID))
+
+	if err := client.DeleteSecondaryDNSZone(ctx, zoneID); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting secondary DNS zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareSecondaryDNSZone(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_secondary_dns_zone.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDomain(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSecondaryDNSZoneConfig(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "name", domain),
+					resource.TestCheckResourceAttr(name, "auto_refresh_seconds", "86400"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareSecondaryDNSZoneConfig(name, zoneID, domain string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_secondary_dns_zone" "%[1]s" {
+		zone_id              = "%[2]s"
+		name                 = "%[3]s"
+		primaries            = [cloudflare_secondary_dns_peer.%[1]s.id]
+		auto_refresh_seconds = 86400
+	}
+
+	resource "cloudflare_secondary_dns_peer" "%[1]s" {
+		account_id = "%[4]s"
+		name       = "%[1]s"
+		ip         = "192.0.2.53"
+		port       = 53
+	}`, name, zoneID, domain, os.Getenv("CLOUDFLARE_ACCOUNT_ID"))
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareSecurityCenterInsightsMute(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_security_center_insights_mute.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSecurityCenterInsightsMuteConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "issue_class", "compliance_violation"),
+					resource.TestCheckResourceAttr(name, "severity", "low"),
+					resource.TestCheckResourceAttrSet(name, "dismissed_insight_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareSecurityCenterInsightsMuteConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_security_center_insights_mute" "%[1]s" {
+		account_id  = "%[2]s"
+		issue_class = "compliance_violation"
+		severity    = "low"
+	}`, name, accountID)
+}
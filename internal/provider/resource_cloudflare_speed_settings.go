@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// speedSettingIDs are the zone setting identifiers managed by this resource,
+// matching the attribute names of its schema.
+var speedSettingIDs = []string{"early_hints", "mirage", "prefetch_preload", "rocket_loader", "speed_brain"}
+
+func resourceCloudflareSpeedSettings() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareSpeedSettingsSchema(),
+		CreateContext: resourceCloudflareSpeedSettingsCreate,
+		ReadContext:   resourceCloudflareSpeedSettingsRead,
+		UpdateContext: resourceCloudflareSpeedSettingsUpdate,
+		DeleteContext: resourceCloudflareSpeedSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceCloudflareSpeedSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	d.SetId(zoneID)
+
+	if err := updateSpeedSettings(ctx, d, client, false); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareSpeedSettingsRead(ctx, d, meta)
+}
+
+func resourceCloudflareSpeedSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	d.Set("zone_id", d.Id())
+
+	zoneSettings, err := client.ZoneSettings(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading settings of zone %q: %w", d.Id(), err))
+	}
+
+	for _, setting := range zoneSettings.Result {
+		if !contains(speedSettingIDs, setting.ID) {
+			continue
+		}
+		if value, ok := setting.Value.(string); ok {
+			d.Set(setting.ID, value)
+		}
+	}
+
+	return nil
+}
+
+func resourceCloudflareSpeedSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	if err := updateSpeedSettings(ctx, d, client, true); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareSpeedSettingsRead(ctx, d, meta)
+}
+
+func resourceCloudflareSpeedSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Zone settings cannot be deleted, only changed, so removing the resource
+	// leaves the settings at their current values.
+	tflog.Debug(ctx, fmt.Sprintf("Removing speed settings of zone %q from state, settings are left unchanged", d.Id()))
+
+	return nil
+}
+
+// updateSpeedSettings submits the configured speed settings of the zone,
+// checking for each one that the zone is entitled to it before submission so
+// a plan limitation surfaces as a clear error rather than a generic API
+// failure. When onlyChanged is set, settings whose configuration did not
+// change are left untouched.
+func updateSpeedSettings(ctx context.Context, d *schema.ResourceData, client *cloudflare.API, onlyChanged bool) error {
+	zoneID := d.Get("zone_id").(string)
+
+	zoneSettings, err := client.ZoneSettings(ctx, zoneID)
+	if err != nil {
+		return fmt.Errorf("error reading settings of zone %q: %w", zoneID, err)
+	}
+
+	currentSettings := map[string]cloudflare.ZoneSetting{}
+	for _, setting := range zoneSettings.Result {
+		currentSettings[setting.ID] = setting
+	}
+
+	updatedSettings := []cloudflare.ZoneSetting{}
+	for _, settingID := range speedSettingIDs {
+		value, ok := d.GetOk(settingID)
+		if !ok || (onlyChanged && !d.HasChange(settingID)) {
+			continue
+		}
+
+		setting, found := currentSettings[settingID]
+		if !found {
+			return fmt.Errorf("zone %q is not entitled to setting %q", zoneID, settingID)
+		}
+		if !setting.Editable {
+			return fmt.Errorf("setting %q is not editable on the current plan of zone %q", settingID, zoneID)
+		}
+
+		updatedSettings = append(updatedSettings, cloudflare.ZoneSetting{
+			ID:    settingID,
+			Value: value.(string),
+		})
+	}
+
+	if len(updatedSettings) == 0 {
+		return nil
+	}
+
+	if _, err := client.UpdateZoneSettings(ctx, zoneID, updatedSettings); err != nil {
+		return fmt.Errorf("error updating settings of zone %q: %w", zoneID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareSpeedSettings(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_speed_settings.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSpeedSettingsConfig(rnd, zoneID, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "early_hints", "on"),
+					resource.TestCheckResourceAttr(name, "prefetch_preload", "on"),
+				),
+			},
+			{
+				Config: testAccCloudflareSpeedSettingsConfig(rnd, zoneID, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "early_hints", "off"),
+					resource.TestCheckResourceAttr(name, "prefetch_preload", "off"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareSpeedSettingsConfig(name, zoneID, value string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_speed_settings" "%[1]s" {
+		zone_id          = "%[2]s"
+		early_hints      = "%[3]s"
+		prefetch_preload = "%[3]s"
+	}`, name, zoneID, value)
+}
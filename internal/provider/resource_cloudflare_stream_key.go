@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// streamKey is the API representation of a Stream signing key. The private
+// key material is only returned when the key is created.
+type streamKey struct {
+	ID      string `json:"id"`
+	PEM     string `json:"pem,omitempty"`
+	JWK     string `json:"jwk,omitempty"`
+	Created string `json:"created,omitempty"`
+}
+
+func resourceCloudflareStreamKey() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"pem": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64-encoded PEM private key used to sign URL tokens. Only available when the key is created.",
+			},
+			"jwk": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64-encoded JWK of the private key. Only available when the key is created.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of when the key was created.",
+			},
+		},
+		CreateContext: resourceCloudflareStreamKeyCreate,
+		ReadContext:   resourceCloudflareStreamKeyRead,
+		DeleteContext: resourceCloudflareStreamKeyDelete,
+		Description:   "Provides a Cloudflare Stream signing key resource, used to sign tokens for videos that require signed URLs. The private key material is only returned on creation, so the resource cannot be imported.",
+	}
+}
+
+func streamKeyEndpoint(accountID, keyID string) string {
+	endpoint := fmt.Sprintf("/accounts/%s/stream/keys", accountID)
+	if keyID != "" {
+		endpoint = fmt.Sprintf("%s/%s", endpoint, keyID)
+	}
+	return endpoint
+}
+
+func resourceCloudflareStreamKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodPost, streamKeyEndpoint(accountID, ""), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Stream signing key: %w", err))
+	}
+
+	var key streamKey
+	if err := json.Unmarshal(res, &key); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Stream signing key: %w", err))
+	}
+
+	d.SetId(key.ID)
+
+	// the private key material is only returned on creation and cannot be
+	// refreshed afterwards
+	d.Set("pem", key.PEM)
+	d.Set("jwk", key.JWK)
+	d.Set("created", key.Created)
+
+	return nil
+}
+
+func resourceCloudflareStreamKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodGet, streamKeyEndpoint(accountID, ""), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Stream signing keys: %w", err))
+	}
+
+	var keys []streamKey
+	if err := json.Unmarshal(res, &keys); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Stream signing keys: %w", err))
+	}
+
+	for _, key := range keys {
+		if key.ID == d.Id() {
+			d.Set("created", key.Created)
+			return nil
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudflareStreamKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	_, err := client.Raw(http.MethodDelete, streamKeyEndpoint(accountID, d.Id()), nil)
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error deleting Stream signing key %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareStreamKey(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_stream_key.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareStreamKeyConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "pem"),
+					resource.TestCheckResourceAttrSet(name, "jwk"),
+					resource.TestCheckResourceAttrSet(name, "created"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareStreamKeyConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_stream_key" "%[1]s" {
+		account_id = "%[2]s"
+	}`, name, accountID)
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// streamLiveInputOutput is one connection endpoint of a live input; the API
+// uses the same shape for the RTMPS, SRT and WebRTC ingest and playback
+// endpoints, with only the relevant fields populated.
+type streamLiveInputOutput struct {
+	URL        string `json:"url"`
+	StreamKey  string `json:"streamKey"`
+	StreamID   string `json:"streamId"`
+	Passphrase string `json:"passphrase"`
+}
+
+type streamLiveInputRecording struct {
+	Mode              string   `json:"mode,omitempty"`
+	TimeoutSeconds    int      `json:"timeoutSeconds,omitempty"`
+	RequireSignedURLs *bool    `json:"requireSignedURLs,omitempty"`
+	AllowedOrigins    []string `json:"allowedOrigins,omitempty"`
+}
+
+// streamLiveInput is the API representation of a Stream live input; the
+// Stream API uses camelCase field names.
+type streamLiveInput struct {
+	UID                      string                    `json:"uid,omitempty"`
+	Meta                     map[string]interface{}    `json:"meta,omitempty"`
+	Recording                *streamLiveInputRecording `json:"recording,omitempty"`
+	DeleteRecordingAfterDays int                       `json:"deleteRecordingAfterDays,omitempty"`
+	RTMPS                    *streamLiveInputOutput    `json:"rtmps,omitempty"`
+	RTMPSPlayback            *streamLiveInputOutput    `json:"rtmpsPlayback,omitempty"`
+	SRT                      *streamLiveInputOutput    `json:"srt,omitempty"`
+	SRTPlayback              *streamLiveInputOutput    `json:"srtPlayback,omitempty"`
+	WebRTC                   *streamLiveInputOutput    `json:"webRTC,omitempty"`
+	WebRTCPlayback           *streamLiveInputOutput    `json:"webRTCPlayback,omitempty"`
+	Created                  string                    `json:"created,omitempty"`
+	Modified                 string                    `json:"modified,omitempty"`
+}
+
+func resourceCloudflareStreamLiveInput() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareStreamLiveInputSchema(),
+		CreateContext: resourceCloudflareStreamLiveInputCreate,
+		ReadContext:   resourceCloudflareStreamLiveInputRead,
+		UpdateContext: resourceCloudflareStreamLiveInputUpdate,
+		DeleteContext: resourceCloudflareStreamLiveInputDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareStreamLiveInputImport,
+		},
+		Description: "Provides a Cloudflare Stream live input resource, with RTMPS and SRT ingest endpoints and optional recording of the streamed content.",
+	}
+}
+
+func streamLiveInputEndpoint(accountID, uid string) string {
+	endpoint := fmt.Sprintf("/accounts/%s/stream/live_inputs", accountID)
+	if uid != "" {
+		endpoint = fmt.Sprintf("%s/%s", endpoint, uid)
+	}
+	return endpoint
+}
+
+func expandStreamLiveInput(d *schema.ResourceData) streamLiveInput {
+	input := streamLiveInput{
+		DeleteRecordingAfterDays: d.Get("delete_recording_after_days").(int),
+	}
+
+	if name := d.Get("name").(string); name != "" {
+		input.Meta = map[string]interface{}{"name": name}
+	}
+
+	if recording, ok := d.GetOk("recording"); ok {
+		cfg := recording.([]interface{})[0].(map[string]interface{})
+		requireSignedURLs := cfg["require_signed_urls"].(bool)
+		input.Recording = &streamLiveInputRecording{
+			Mode:              cfg["mode"].(string),
+			TimeoutSeconds:    cfg["timeout_seconds"].(int),
+			RequireSignedURLs: &requireSignedURLs,
+			AllowedOrigins:    expandInterfaceToStringList(cfg["allowed_origins"].([]interface{})),
+		}
+	}
+
+	return input
+}
+
+func setStreamLiveInputData(d *schema.ResourceData, input streamLiveInput) {
+	if name, ok := input.Meta["name"].(string); ok {
+		d.Set("name", name)
+	}
+	d.Set("delete_recording_after_days", input.DeleteRecordingAfterDays)
+
+	if input.Recording != nil {
+		recording := map[string]interface{}{
+			"mode":            input.Recording.Mode,
+			"timeout_seconds": input.Recording.TimeoutSeconds,
+			"allowed_origins": input.Recording.AllowedOrigins,
+		}
+		if input.Recording.RequireSignedURLs != nil {
+			recording["require_signed_urls"] = *input.Recording.RequireSignedURLs
+		}
+		d.Set("recording", []interface{}{recording})
+	}
+
+	if input.RTMPS != nil {
+		d.Set("rtmps_url", input.RTMPS.URL)
+		d.Set("rtmps_stream_key", input.RTMPS.StreamKey)
+	}
+	if input.RTMPSPlayback != nil {
+		d.Set("rtmps_playback_url", input.RTMPSPlayback.URL)
+		d.Set("rtmps_playback_stream_key", input.RTMPSPlayback.StreamKey)
+	}
+	if input.SRT != nil {
+		d.Set("srt_url", input.SRT.URL)
+		d.Set("srt_stream_id", input.SRT.StreamID)
+		d.Set("srt_passphrase", input.SRT.Passphrase)
+	}
+	if input.WebRTC != nil {
+		d.Set("web_rtc_url", input.WebRTC.URL)
+	}
+	if input.WebRTCPlayback != nil {
+		d.Set("web_rtc_playback_url", input.WebRTCPlayback.URL)
+	}
+}
+
+func resourceCloudflareStreamLiveInputCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodPost, streamLiveInputEndpoint(accountID, ""), expandStreamLiveInput(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Stream live input: %w", err))
+	}
+
+	var input streamLiveInput
+	if err := json.Unmarshal(res, &input); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Stream live input: %w", err))
+	}
+
+	d.SetId(input.UID)
+
+	return resourceCloudflareStreamLiveInputRead(ctx, d, meta)
+}
+
+func resourceCloudflareStreamLiveInputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodGet, streamLiveInputEndpoint(accountID, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Stream live input %q: %w", d.Id(), err))
+	}
+
+	var input streamLiveInput
+	if err := json.Unmarshal(res, &input); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Stream live input: %w", err))
+	}
+
+	setStreamLiveInputData(d, input)
+
+	return nil
+}
+
+func resourceCloudflareStreamLiveInputUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	_, err := client.Raw(http.MethodPut, streamLiveInputEndpoint(accountID, d.Id()), expandStreamLiveInput(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Stream live input %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareStreamLiveInputRead(ctx, d, meta)
+}
+
+func resourceCloudflareStreamLiveInputDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	_, err := client.Raw(http.MethodDelete, streamLiveInputEndpoint(accountID, d.Id()), nil)
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error deleting Stream live input %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareStreamLiveInputImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id %q specified, should be in format "accountID/liveInputUID"`, d.Id())
+	}
+
+	accountID, uid := attributes[0], attributes[1]
+
+	d.Set("account_id", accountID)
+	d.SetId(uid)
+
+	resourceCloudflareStreamLiveInputRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareStreamLiveInput(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_stream_live_input.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareStreamLiveInputConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "recording.0.mode", "automatic"),
+					resource.TestCheckResourceAttrSet(name, "rtmps_url"),
+					resource.TestCheckResourceAttrSet(name, "rtmps_stream_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareStreamLiveInputConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_stream_live_input" "%[1]s" {
+		account_id = "%[2]s"
+		name       = "%[1]s"
+
+		recording {
+			mode            = "automatic"
+			timeout_seconds = 30
+		}
+	}`, name, accountID)
+}
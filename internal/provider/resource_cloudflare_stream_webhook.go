@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// streamWebhook is the API representation of the Stream webhook of an
+// account; there is at most one per account.
+type streamWebhook struct {
+	NotificationURL string `json:"notificationUrl"`
+	Secret          string `json:"secret,omitempty"`
+}
+
+func resourceCloudflareStreamWebhook() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"notification_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+				Description:  "The URL Stream sends video state change notifications to.",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret the notification signatures are generated with.",
+			},
+		},
+		CreateContext: resourceCloudflareStreamWebhookCreate,
+		ReadContext:   resourceCloudflareStreamWebhookRead,
+		UpdateContext: resourceCloudflareStreamWebhookCreate,
+		DeleteContext: resourceCloudflareStreamWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareStreamWebhookImport,
+		},
+		Description: "Provides a resource which manages the Stream webhook of an account, which receives video state change notifications. An account has at most one Stream webhook.",
+	}
+}
+
+func streamWebhookEndpoint(accountID string) string {
+	return fmt.Sprintf("/accounts/%s/stream/webhook", accountID)
+}
+
+func resourceCloudflareStreamWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodPut, streamWebhookEndpoint(accountID), streamWebhook{
+		NotificationURL: d.Get("notification_url").(string),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting Stream webhook: %w", err))
+	}
+
+	var webhook streamWebhook
+	if err := json.Unmarshal(res, &webhook); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Stream webhook: %w", err))
+	}
+
+	d.SetId(accountID)
+	d.Set("secret", webhook.Secret)
+
+	return resourceCloudflareStreamWebhookRead(ctx, d, meta)
+}
+
+func resourceCloudflareStreamWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	res, err := client.Raw(http.MethodGet, streamWebhookEndpoint(d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Stream webhook: %w", err))
+	}
+
+	var webhook streamWebhook
+	if err := json.Unmarshal(res, &webhook); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Stream webhook: %w", err))
+	}
+
+	if webhook.NotificationURL == "" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", d.Id())
+	d.Set("notification_url", webhook.NotificationURL)
+
+	return nil
+}
+
+func resourceCloudflareStreamWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	_, err := client.Raw(http.MethodDelete, streamWebhookEndpoint(d.Id()), nil)
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error deleting Stream webhook: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareStreamWebhookImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("account_id", d.Id())
+
+	resourceCloudflareStreamWebhookRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareStreamWebhook(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_stream_webhook.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareStreamWebhookConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "notification_url", "https://example.com/stream-webhook"),
+					resource.TestCheckResourceAttrSet(name, "secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareStreamWebhookConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_stream_webhook" "%[1]s" {
+		account_id       = "%[2]s"
+		notification_url = "https://example.com/stream-webhook"
+	}`, name, accountID)
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type teamsCertificate struct {
+	ID            string `json:"id,omitempty"`
+	Certificate   string `json:"certificate,omitempty"`
+	IssuerOrg     string `json:"issuer_org,omitempty"`
+	BindingStatus string `json:"binding_status,omitempty"`
+	InUse         bool   `json:"in_use,omitempty"`
+	ExpiresOn     string `json:"expires_on,omitempty"`
+	UploadedOn    string `json:"uploaded_on,omitempty"`
+}
+
+type teamsCertificateCreateRequest struct {
+	Certificate        string `json:"certificate,omitempty"`
+	PrivateKey         string `json:"private_key,omitempty"`
+	ValidityPeriodDays int    `json:"validity_period_days,omitempty"`
+}
+
+func resourceCloudflareTeamsCertificate() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareTeamsCertificateSchema(),
+		CreateContext: resourceCloudflareTeamsCertificateCreate,
+		ReadContext:   resourceCloudflareTeamsCertificateRead,
+		UpdateContext: resourceCloudflareTeamsCertificateUpdate,
+		DeleteContext: resourceCloudflareTeamsCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTeamsCertificateImport,
+		},
+		Description: "Provides a Cloudflare Teams Gateway certificate resource. The certificate is used as the root CA for TLS decryption and can be distributed to endpoints via MDM configuration.",
+	}
+}
+
+func teamsCertificateEndpoint(accountID, certificateID string) string {
+	endpoint := fmt.Sprintf("/accounts/%s/gateway/certificates", accountID)
+	if certificateID != "" {
+		endpoint = fmt.Sprintf("%s/%s", endpoint, certificateID)
+	}
+	return endpoint
+}
+
+func resourceCloudflareTeamsCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	request := teamsCertificateCreateRequest{
+		Certificate: d.Get("certificate").(string),
+		PrivateKey:  d.Get("private_key").(string),
+	}
+	if request.Certificate == "" {
+		request.ValidityPeriodDays = d.Get("validity_period_days").(int)
+	}
+
+	res, err := client.Raw(http.MethodPost, teamsCertificateEndpoint(accountID, ""), request)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create Teams certificate: %w", err))
+	}
+
+	var certificate teamsCertificate
+	if err := json.Unmarshal(res, &certificate); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to decode Teams certificate create response: %w", err))
+	}
+
+	d.SetId(certificate.ID)
+
+	if d.Get("activate").(bool) {
+		if err := setTeamsCertificateActivation(client, accountID, certificate.ID, true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudflareTeamsCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareTeamsCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(http.MethodGet, teamsCertificateEndpoint(accountID, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			tflog.Info(ctx, fmt.Sprintf("Teams certificate %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to fetch Teams certificate: %w", err))
+	}
+
+	var certificate teamsCertificate
+	if err := json.Unmarshal(res, &certificate); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to decode Teams certificate: %w", err))
+	}
+
+	d.Set("certificate_pem", certificate.Certificate)
+	d.Set("binding_status", certificate.BindingStatus)
+	d.Set("in_use", certificate.InUse)
+	d.Set("issuer_org", certificate.IssuerOrg)
+	d.Set("expires_on", certificate.ExpiresOn)
+	d.Set("uploaded_on", certificate.UploadedOn)
+
+	return nil
+}
+
+func resourceCloudflareTeamsCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	if d.HasChange("activate") {
+		if err := setTeamsCertificateActivation(client, accountID, d.Id(), d.Get("activate").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudflareTeamsCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareTeamsCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	// Active certificates cannot be deleted so deactivate before removal.
+	if d.Get("activate").(bool) {
+		if err := setTeamsCertificateActivation(client, accountID, d.Id(), false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	_, err := client.Raw(http.MethodDelete, teamsCertificateEndpoint(accountID, d.Id()), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete Teams certificate: %w", err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func setTeamsCertificateActivation(client *cloudflare.API, accountID, certificateID string, activate bool) error {
+	action := "deactivate"
+	if activate {
+		action = "activate"
+	}
+
+	_, err := client.Raw(http.MethodPost, fmt.Sprintf("%s/%s", teamsCertificateEndpoint(accountID, certificateID), action), nil)
+	if err != nil {
+		return fmt.Errorf("failed to %s Teams certificate: %w", action, err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareTeamsCertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/certificateID\"", d.Id())
+	}
+
+	accountID, certificateID := attributes[0], attributes[1]
+
+	d.Set("account_id", accountID)
+	d.SetId(certificateID)
+
+	resourceCloudflareTeamsCertificateRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareTeamsCertificate_Generated(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_teams_certificate.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsCertificateGenerated(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttrSet(name, "certificate_pem"),
+					resource.TestCheckResourceAttrSet(name, "expires_on"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareTeamsCertificateGenerated(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_certificate" "%[1]s" {
+  account_id = "%[2]s"
+}`, rnd, accountID)
+}
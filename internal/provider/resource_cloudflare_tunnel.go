@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceCloudflareTunnel is the renamed form of
+// resourceCloudflareArgoTunnel. Both share the schema and CRUD operations so
+// no attributes change when moving over. SDKv2 has no hook for migrating
+// state across resource types automatically, so adopting the new name needs
+// a one-time `terraform state mv` (or `terraform import`) per resource.
+func resourceCloudflareTunnel() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareArgoTunnelSchema(),
+		CreateContext: resourceCloudflareArgoTunnelCreate,
+		ReadContext:   resourceCloudflareArgoTunnelRead,
+		DeleteContext: resourceCloudflareArgoTunnelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareArgoTunnelImport,
+		},
+	}
+}
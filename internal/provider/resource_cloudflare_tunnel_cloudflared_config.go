@@ -0,0 +1,362 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareTunnelCloudflaredConfig() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareTunnelCloudflaredConfigSchema(),
+		CreateContext: resourceCloudflareTunnelCloudflaredConfigUpdate,
+		ReadContext:   resourceCloudflareTunnelCloudflaredConfigRead,
+		UpdateContext: resourceCloudflareTunnelCloudflaredConfigUpdate,
+		DeleteContext: resourceCloudflareTunnelCloudflaredConfigDelete,
+		CustomizeDiff: resourceCloudflareTunnelCloudflaredConfigDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTunnelCloudflaredConfigImport,
+		},
+		Description: "Provides a resource which manages the remotely-managed configuration (ingress rules, origin request settings and WARP routing) of a Cloudflare Tunnel running cloudflared.",
+	}
+}
+
+// validateTunnelIngressService checks that an ingress rule service is one the
+// cloudflared daemon would accept, so broken configurations are caught at
+// plan time instead of leaving a half-written config behind.
+func validateTunnelIngressService(service string) error {
+	if service == "hello_world" || service == "bastion" {
+		return nil
+	}
+
+	if strings.HasPrefix(service, "http_status:") {
+		status := strings.TrimPrefix(service, "http_status:")
+		if code, err := strconv.Atoi(status); err != nil || code < 100 || code > 599 {
+			return fmt.Errorf("%q is not a valid HTTP status code", status)
+		}
+		return nil
+	}
+
+	if strings.HasPrefix(service, "unix:") || strings.HasPrefix(service, "unix+tls:") {
+		return nil
+	}
+
+	u, err := url.Parse(service)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid URL: %w", service, err)
+	}
+
+	switch u.Scheme {
+	case "http", "https", "tcp", "ssh", "rdp", "smb", "udp":
+	default:
+		return fmt.Errorf("%q is not a supported service scheme", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return fmt.Errorf("%q is missing a host", service)
+	}
+
+	return nil
+}
+
+func resourceCloudflareTunnelCloudflaredConfigDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rules := d.Get("config.0.ingress_rule").([]interface{})
+	if len(rules) == 0 {
+		return nil
+	}
+
+	seen := map[string]int{}
+	for i, rawRule := range rules {
+		rule := rawRule.(map[string]interface{})
+		hostname := rule["hostname"].(string)
+		path := rule["path"].(string)
+		service := rule["service"].(string)
+
+		if err := validateTunnelIngressService(service); err != nil {
+			return fmt.Errorf("config.0.ingress_rule.%d: invalid service: %w", i, err)
+		}
+
+		if hostname == "" && i != len(rules)-1 {
+			return fmt.Errorf("config.0.ingress_rule.%d: rules without a hostname act as the catch-all and must come last", i)
+		}
+
+		key := hostname + path
+		if hostname != "" {
+			if previous, ok := seen[key]; ok {
+				return fmt.Errorf("config.0.ingress_rule.%d: duplicates the hostname and path of rule %d; the duplicate would never be matched", i, previous)
+			}
+			seen[key] = i
+		}
+	}
+
+	last := rules[len(rules)-1].(map[string]interface{})
+	if last["hostname"].(string) != "" {
+		return fmt.Errorf("config.0.ingress_rule: the last rule must be a catch-all without a hostname, e.g. `service = \"http_status:404\"`")
+	}
+
+	return nil
+}
+
+func buildTunnelConfiguration(d *schema.ResourceData) (cloudflare.TunnelConfiguration, error) {
+	config := cloudflare.TunnelConfiguration{}
+
+	for _, rawRule := range d.Get("config.0.ingress_rule").([]interface{}) {
+		rule := rawRule.(map[string]interface{})
+		config.Ingress = append(config.Ingress, cloudflare.UnvalidatedIngressRule{
+			Hostname: rule["hostname"].(string),
+			Path:     rule["path"].(string),
+			Service:  rule["service"].(string),
+		})
+	}
+
+	if warpRouting := d.Get("config.0.warp_routing").([]interface{}); len(warpRouting) == 1 {
+		config.WarpRouting = &cloudflare.WarpRoutingConfig{
+			Enabled: warpRouting[0].(map[string]interface{})["enabled"].(bool),
+		}
+	}
+
+	if originRequest := d.Get("config.0.origin_request").([]interface{}); len(originRequest) == 1 {
+		expanded, err := expandTunnelOriginRequest(originRequest[0].(map[string]interface{}))
+		if err != nil {
+			return config, err
+		}
+		config.OriginRequest = expanded
+	}
+
+	return config, nil
+}
+
+func expandTunnelOriginRequest(data map[string]interface{}) (cloudflare.OriginRequestConfig, error) {
+	origin := cloudflare.OriginRequestConfig{}
+
+	durations := map[string]**time.Duration{
+		"connect_timeout":    &origin.ConnectTimeout,
+		"tls_timeout":        &origin.TLSTimeout,
+		"tcp_keep_alive":     &origin.TCPKeepAlive,
+		"keep_alive_timeout": &origin.KeepAliveTimeout,
+	}
+	for key, target := range durations {
+		if value := data[key].(string); value != "" {
+			duration, err := time.ParseDuration(value)
+			if err != nil {
+				return origin, fmt.Errorf("invalid %s: %w", key, err)
+			}
+			*target = &duration
+		}
+	}
+
+	if value := data["http_host_header"].(string); value != "" {
+		origin.HTTPHostHeader = cloudflare.StringPtr(value)
+	}
+	if value := data["origin_server_name"].(string); value != "" {
+		origin.OriginServerName = cloudflare.StringPtr(value)
+	}
+	if value := data["ca_pool"].(string); value != "" {
+		origin.CAPool = cloudflare.StringPtr(value)
+	}
+	if value := data["proxy_address"].(string); value != "" {
+		origin.ProxyAddress = cloudflare.StringPtr(value)
+	}
+	if value := data["proxy_type"].(string); value != "" {
+		origin.ProxyType = cloudflare.StringPtr(value)
+	}
+	if value := data["proxy_port"].(int); value != 0 {
+		port := uint(value)
+		origin.ProxyPort = &port
+	}
+	if value := data["keep_alive_connections"].(int); value != 0 {
+		origin.KeepAliveConnections = cloudflare.IntPtr(value)
+	}
+	if data["no_happy_eyeballs"].(bool) {
+		origin.NoHappyEyeballs = cloudflare.BoolPtr(true)
+	}
+	if data["no_tls_verify"].(bool) {
+		origin.NoTLSVerify = cloudflare.BoolPtr(true)
+	}
+	if data["disable_chunked_encoding"].(bool) {
+		origin.DisableChunkedEncoding = cloudflare.BoolPtr(true)
+	}
+	if data["bastion_mode"].(bool) {
+		origin.BastionMode = cloudflare.BoolPtr(true)
+	}
+
+	for _, rawRule := range data["ip_rule"].(*schema.Set).List() {
+		rule := rawRule.(map[string]interface{})
+		ipRule := cloudflare.IngressIPRule{
+			Prefix: cloudflare.StringPtr(rule["prefix"].(string)),
+			Allow:  rule["allow"].(bool),
+		}
+		for _, port := range rule["ports"].([]interface{}) {
+			ipRule.Ports = append(ipRule.Ports, port.(int))
+		}
+		origin.IPRules = append(origin.IPRules, ipRule)
+	}
+
+	return origin, nil
+}
+
+func flattenTunnelOriginRequest(origin cloudflare.OriginRequestConfig) []interface{} {
+	data := map[string]interface{}{}
+
+	if origin.ConnectTimeout != nil {
+		data["connect_timeout"] = origin.ConnectTimeout.String()
+	}
+	if origin.TLSTimeout != nil {
+		data["tls_timeout"] = origin.TLSTimeout.String()
+	}
+	if origin.TCPKeepAlive != nil {
+		data["tcp_keep_alive"] = origin.TCPKeepAlive.String()
+	}
+	if origin.KeepAliveTimeout != nil {
+		data["keep_alive_timeout"] = origin.KeepAliveTimeout.String()
+	}
+	if origin.HTTPHostHeader != nil {
+		data["http_host_header"] = *origin.HTTPHostHeader
+	}
+	if origin.OriginServerName != nil {
+		data["origin_server_name"] = *origin.OriginServerName
+	}
+	if origin.CAPool != nil {
+		data["ca_pool"] = *origin.CAPool
+	}
+	if origin.ProxyAddress != nil {
+		data["proxy_address"] = *origin.ProxyAddress
+	}
+	if origin.ProxyType != nil {
+		data["proxy_type"] = *origin.ProxyType
+	}
+	if origin.ProxyPort != nil {
+		data["proxy_port"] = int(*origin.ProxyPort)
+	}
+	if origin.KeepAliveConnections != nil {
+		data["keep_alive_connections"] = *origin.KeepAliveConnections
+	}
+	if origin.NoHappyEyeballs != nil {
+		data["no_happy_eyeballs"] = *origin.NoHappyEyeballs
+	}
+	if origin.NoTLSVerify != nil {
+		data["no_tls_verify"] = *origin.NoTLSVerify
+	}
+	if origin.DisableChunkedEncoding != nil {
+		data["disable_chunked_encoding"] = *origin.DisableChunkedEncoding
+	}
+	if origin.BastionMode != nil {
+		data["bastion_mode"] = *origin.BastionMode
+	}
+
+	ipRules := []interface{}{}
+	for _, rule := range origin.IPRules {
+		ipRules = append(ipRules, map[string]interface{}{
+			"prefix": cloudflare.String(rule.Prefix),
+			"ports":  flattenIntList(rule.Ports),
+			"allow":  rule.Allow,
+		})
+	}
+	if len(ipRules) > 0 {
+		data["ip_rule"] = ipRules
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+	return []interface{}{data}
+}
+
+func resourceCloudflareTunnelCloudflaredConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	tunnelID := d.Get("tunnel_id").(string)
+
+	config, err := buildTunnelConfiguration(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.UpdateTunnelConfiguration(ctx, cloudflare.TunnelConfigurationParams{
+		AccountID: accountID,
+		TunnelID:  tunnelID,
+		Config:    config,
+	}); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating configuration for tunnel %q: %w", tunnelID, err))
+	}
+
+	d.SetId(tunnelID)
+
+	return resourceCloudflareTunnelCloudflaredConfigRead(ctx, d, meta)
+}
+
+func resourceCloudflareTunnelCloudflaredConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	result, err := client.GetTunnelConfiguration(ctx, cloudflare.GetTunnelConfigurationParams{
+		AccountID: accountID,
+		TunnelID:  d.Id(),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading configuration for tunnel %q: %w", d.Id(), err))
+	}
+
+	config := map[string]interface{}{}
+
+	rules := []interface{}{}
+	for _, rule := range result.Config.Ingress {
+		rules = append(rules, map[string]interface{}{
+			"hostname": rule.Hostname,
+			"path":     rule.Path,
+			"service":  rule.Service,
+		})
+	}
+	config["ingress_rule"] = rules
+
+	if result.Config.WarpRouting != nil {
+		config["warp_routing"] = []interface{}{map[string]interface{}{
+			"enabled": result.Config.WarpRouting.Enabled,
+		}}
+	}
+
+	if originRequest := flattenTunnelOriginRequest(result.Config.OriginRequest); originRequest != nil {
+		config["origin_request"] = originRequest
+	}
+
+	d.Set("tunnel_id", result.TunnelID)
+	if err := d.Set("config", []interface{}{config}); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tunnel config: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareTunnelCloudflaredConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// the API has no delete for tunnel configurations; the configuration is
+	// removed together with the tunnel itself
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudflareTunnelCloudflaredConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/tunnelID\"", d.Id())
+	}
+
+	accountID, tunnelID := attributes[0], attributes[1]
+	d.Set("account_id", accountID)
+	d.SetId(tunnelID)
+
+	resourceCloudflareTunnelCloudflaredConfigRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
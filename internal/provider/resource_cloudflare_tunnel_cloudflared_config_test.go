@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestValidateTunnelIngressService(t *testing.T) {
+	valid := []string{
+		"http://localhost:8000",
+		"https://origin.example.com",
+		"tcp://localhost:22",
+		"ssh://localhost:22",
+		"rdp://localhost:3389",
+		"unix:/var/run/app.sock",
+		"unix+tls:/var/run/app.sock",
+		"http_status:404",
+		"hello_world",
+		"bastion",
+	}
+	for _, service := range valid {
+		if err := validateTunnelIngressService(service); err != nil {
+			t.Errorf("expected %q to be valid, got: %v", service, err)
+		}
+	}
+
+	invalid := []string{
+		"ftp://localhost:21",
+		"http_status:999",
+		"http_status:abc",
+		"http://",
+		"localhost:8000",
+	}
+	for _, service := range invalid {
+		if err := validateTunnelIngressService(service); err == nil {
+			t.Errorf("expected %q to be rejected", service)
+		}
+	}
+}
+
+func TestAccCloudflareTunnelCloudflaredConfig(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Tunnel
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_tunnel_cloudflared_config.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTunnelCloudflaredConfigBasic(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "config.#", "1"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.#", "2"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.hostname", "app.example.com"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.service", "http://localhost:8000"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.1.service", "http_status:404"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareTunnelCloudflaredConfigBasic(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_tunnel" "%[1]s" {
+		account_id = "%[2]s"
+		name       = "%[1]s"
+		secret     = "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="
+	}
+
+	resource "cloudflare_tunnel_cloudflared_config" "%[1]s" {
+		account_id = "%[2]s"
+		tunnel_id  = cloudflare_tunnel.%[1]s.id
+
+		config {
+			ingress_rule {
+				hostname = "app.example.com"
+				service  = "http://localhost:8000"
+			}
+
+			ingress_rule {
+				service = "http_status:404"
+			}
+		}
+	}`, name, accountID)
+}
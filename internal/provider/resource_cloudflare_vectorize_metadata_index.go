@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareVectorizeMetadataIndex() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareVectorizeMetadataIndexCreate,
+		ReadContext:   resourceCloudflareVectorizeMetadataIndexRead,
+		DeleteContext: resourceCloudflareVectorizeMetadataIndexDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareVectorizeMetadataIndexImport,
+		},
+		Schema: resourceCloudflareVectorizeMetadataIndexSchema(),
+	}
+}
+
+func resourceCloudflareVectorizeMetadataIndexSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"index_name": {
+			Description: "The name of the Vectorize index to create the metadata index on. The Vectorize index itself is not managed by this resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"property_name": {
+			Description: "The name of the metadata property to index.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"index_type": {
+			Description:  fmt.Sprintf("The type of the indexed metadata property. %s", renderAvailableDocumentationValuesStringSlice([]string{"string", "number", "boolean"})),
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"string", "number", "boolean"}, false),
+		},
+	}
+}
+
+// vectorizeMetadataIndex is the API representation of a Vectorize metadata
+// index, which the pinned client does not yet model.
+type vectorizeMetadataIndex struct {
+	PropertyName string `json:"propertyName"`
+	IndexType    string `json:"indexType,omitempty"`
+}
+
+func vectorizeMetadataIndexEndpoint(accountID, indexName, operation string) string {
+	return fmt.Sprintf("/accounts/%s/vectorize/v2/indexes/%s/metadata_index/%s", accountID, indexName, operation)
+}
+
+func resourceCloudflareVectorizeMetadataIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	indexName := d.Get("index_name").(string)
+
+	metadataIndex := vectorizeMetadataIndex{
+		PropertyName: d.Get("property_name").(string),
+		IndexType:    d.Get("index_type").(string),
+	}
+
+	if _, err := client.Raw(http.MethodPost, vectorizeMetadataIndexEndpoint(accountID, indexName, "create"), metadataIndex); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Vectorize metadata index: %w", err))
+	}
+
+	d.SetId(metadataIndex.PropertyName)
+
+	return resourceCloudflareVectorizeMetadataIndexRead(ctx, d, meta)
+}
+
+func resourceCloudflareVectorizeMetadataIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	indexName := d.Get("index_name").(string)
+
+	res, err := client.Raw(http.MethodGet, vectorizeMetadataIndexEndpoint(accountID, indexName, "list"), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			tflog.Info(ctx, fmt.Sprintf("Vectorize index %s not found", indexName))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error listing Vectorize metadata indexes of %q: %w", indexName, err))
+	}
+
+	var list struct {
+		MetadataIndexes []vectorizeMetadataIndex `json:"metadataIndexes"`
+	}
+	if err := json.Unmarshal(res, &list); err != nil {
+		return diag.FromErr(fmt.Errorf("error decoding Vectorize metadata indexes of %q: %w", indexName, err))
+	}
+
+	for _, metadataIndex := range list.MetadataIndexes {
+		if metadataIndex.PropertyName == d.Id() {
+			d.Set("property_name", metadataIndex.PropertyName)
+			d.Set("index_type", metadataIndex.IndexType)
+			return nil
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Vectorize metadata index %s not found on index %s", d.Id(), indexName))
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudflareVectorizeMetadataIndexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	indexName := d.Get("index_name").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting Vectorize metadata index %s on index %s", d.Id(), indexName))
+
+	payload := vectorizeMetadataIndex{PropertyName: d.Id()}
+	if _, err := client.Raw(http.MethodPost, vectorizeMetadataIndexEndpoint(accountID, indexName, "delete"), payload); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Vectorize metadata index %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareVectorizeMetadataIndexImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (%q) specified, should be in format \"accountID/indexName/propertyName\"", d.Id())
+	}
+
+	d.Set("account_id", attributes[0])
+	d.Set("index_name", attributes[1])
+	d.SetId(attributes[2])
+
+	resourceCloudflareVectorizeMetadataIndexRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
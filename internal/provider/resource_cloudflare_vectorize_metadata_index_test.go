@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareVectorizeMetadataIndex(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_vectorize_metadata_index.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareVectorizeMetadataIndexConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "index_name", rnd),
+					resource.TestCheckResourceAttr(name, "property_name", "category"),
+					resource.TestCheckResourceAttr(name, "index_type", "string"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareVectorizeMetadataIndexConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_vectorize_metadata_index" "%[1]s" {
+		account_id    = "%[2]s"
+		index_name    = "%[1]s"
+		property_name = "category"
+		index_type    = "string"
+	}`, name, accountID)
+}
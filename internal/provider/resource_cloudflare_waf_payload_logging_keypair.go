@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/curve25519"
+)
+
+func resourceCloudflareWAFPayloadLoggingKeypair() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareWAFPayloadLoggingKeypairCreate,
+		ReadContext:   resourceCloudflareWAFPayloadLoggingKeypairRead,
+		DeleteContext: resourceCloudflareWAFPayloadLoggingKeypairDelete,
+		Schema:        resourceCloudflareWAFPayloadLoggingKeypairSchema(),
+	}
+}
+
+func resourceCloudflareWAFPayloadLoggingKeypairSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"rotation_trigger": {
+			Description: "Arbitrary map of values. Changing any entry forces a new keypair to be generated, allowing key rotation to be driven by a timestamp or counter.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+
+		"public_key": {
+			Description: "The generated public key, suitable for the `matched_data` block of a `cloudflare_ruleset` rule.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+
+		"private_key": {
+			Description: "The generated private key, needed to decrypt logged payloads with the `matched-data-cli` tool.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+	}
+}
+
+// generateWAFPayloadLoggingKeypair generates the X25519 keypair used by WAF
+// payload logging, matching the output of `matched-data-cli generate-key-pair`.
+func generateWAFPayloadLoggingKeypair() (publicKey, privateKey string, err error) {
+	priv := make([]byte, curve25519.ScalarSize)
+	if _, err := rand.Read(priv); err != nil {
+		return "", "", fmt.Errorf("failed to generate private key: %w", err)
+	}
+
+	pub, err := curve25519.X25519(priv, curve25519.Basepoint)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to derive public key: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(pub), base64.StdEncoding.EncodeToString(priv), nil
+}
+
+func resourceCloudflareWAFPayloadLoggingKeypairCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	publicKey, privateKey, err := generateWAFPayloadLoggingKeypair()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("public_key", publicKey)
+	d.Set("private_key", privateKey)
+	d.SetId(stringChecksum(publicKey))
+
+	return nil
+}
+
+func resourceCloudflareWAFPayloadLoggingKeypairRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// the keypair only lives in state; there is nothing to refresh
+	return nil
+}
+
+func resourceCloudflareWAFPayloadLoggingKeypairDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestGenerateWAFPayloadLoggingKeypair(t *testing.T) {
+	publicKey, privateKey, err := generateWAFPayloadLoggingKeypair()
+	if err != nil {
+		t.Fatalf("unexpected error generating keypair: %s", err)
+	}
+
+	for name, key := range map[string]string{"public": publicKey, "private": privateKey} {
+		raw, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			t.Errorf("%s key is not valid base64: %s", name, err)
+		}
+		if len(raw) != 32 {
+			t.Errorf("expected %s key to be 32 bytes, got %d", name, len(raw))
+		}
+	}
+
+	otherPublicKey, _, err := generateWAFPayloadLoggingKeypair()
+	if err != nil {
+		t.Fatalf("unexpected error generating keypair: %s", err)
+	}
+	if otherPublicKey == publicKey {
+		t.Error("expected consecutive keypairs to differ")
+	}
+}
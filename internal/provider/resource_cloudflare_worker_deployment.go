@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// workerDeployment is the API representation of a deployment, which splits
+// script traffic by percentage between uploaded versions.
+type workerDeployment struct {
+	ID          string                    `json:"id,omitempty"`
+	Strategy    string                    `json:"strategy"`
+	Versions    []workerDeploymentVersion `json:"versions"`
+	Annotations map[string]string         `json:"annotations,omitempty"`
+}
+
+type workerDeploymentVersion struct {
+	VersionID  string  `json:"version_id"`
+	Percentage float64 `json:"percentage"`
+}
+
+func resourceCloudflareWorkerDeployment() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"script_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the worker script the deployment targets.",
+			},
+			"version": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The identifier of the version to serve, as uploaded by `cloudflare_worker_version`.",
+						},
+						"percentage": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(0.01, 100),
+							Description:  "The percentage of traffic routed to the version. Percentages across all `version` blocks must add up to 100.",
+						},
+					},
+				},
+				Description: "The versions the deployment serves and the percentage of traffic each receives. Up to two versions can be served at once.",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Message annotation attached to the deployment, shown in the dashboard deployment history.",
+			},
+		},
+		CreateContext: resourceCloudflareWorkerDeploymentCreate,
+		ReadContext:   resourceCloudflareWorkerDeploymentRead,
+		UpdateContext: resourceCloudflareWorkerDeploymentCreate,
+		DeleteContext: resourceCloudflareWorkerDeploymentDelete,
+		CustomizeDiff: resourceCloudflareWorkerDeploymentDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWorkerDeploymentImport,
+		},
+		Description: "Provides a resource which manages the active deployment of a worker script, splitting traffic by percentage between uploaded versions for gradual rollouts.",
+	}
+}
+
+func workerDeploymentEndpoint(accountID, scriptName string) string {
+	return fmt.Sprintf("/accounts/%s/workers/scripts/%s/deployments", accountID, scriptName)
+}
+
+func resourceCloudflareWorkerDeploymentDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	total := 0.0
+	for _, rawData := range d.Get("version").([]interface{}) {
+		total += rawData.(map[string]interface{})["percentage"].(float64)
+	}
+	if total != 100 {
+		return fmt.Errorf("deployment version percentages must add up to 100, got %g", total)
+	}
+	return nil
+}
+
+func expandWorkerDeployment(d *schema.ResourceData) workerDeployment {
+	deployment := workerDeployment{
+		Strategy: "percentage",
+	}
+
+	for _, rawData := range d.Get("version").([]interface{}) {
+		data := rawData.(map[string]interface{})
+		deployment.Versions = append(deployment.Versions, workerDeploymentVersion{
+			VersionID:  data["version_id"].(string),
+			Percentage: data["percentage"].(float64),
+		})
+	}
+
+	if message := d.Get("message").(string); message != "" {
+		deployment.Annotations = map[string]string{"workers/message": message}
+	}
+
+	return deployment
+}
+
+func resourceCloudflareWorkerDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	if client.AccountID == "" {
+		return diag.FromErr(fmt.Errorf("account ID is required to manage worker deployments"))
+	}
+	scriptName := d.Get("script_name").(string)
+
+	res, err := client.Raw(http.MethodPost, workerDeploymentEndpoint(client.AccountID, scriptName), expandWorkerDeployment(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating worker deployment: %w", err))
+	}
+
+	var deployment workerDeployment
+	if err := json.Unmarshal(res, &deployment); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling worker deployment: %w", err))
+	}
+
+	d.SetId(deployment.ID)
+
+	return resourceCloudflareWorkerDeploymentRead(ctx, d, meta)
+}
+
+func resourceCloudflareWorkerDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	scriptName := d.Get("script_name").(string)
+
+	res, err := client.Raw(http.MethodGet, workerDeploymentEndpoint(client.AccountID, scriptName), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading worker deployments of script %q: %w", scriptName, err))
+	}
+
+	var deployments struct {
+		Deployments []workerDeployment `json:"deployments"`
+	}
+	if err := json.Unmarshal(res, &deployments); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling worker deployments: %w", err))
+	}
+
+	// the deployment history only grows; the resource tracks the one it
+	// created and treats a newer deployment made outside Terraform as drift
+	// to recreate from
+	if len(deployments.Deployments) == 0 || deployments.Deployments[0].ID != d.Id() {
+		d.SetId("")
+		return nil
+	}
+	deployment := deployments.Deployments[0]
+
+	versions := make([]map[string]interface{}, 0, len(deployment.Versions))
+	for _, version := range deployment.Versions {
+		versions = append(versions, map[string]interface{}{
+			"version_id": version.VersionID,
+			"percentage": version.Percentage,
+		})
+	}
+	d.Set("version", versions)
+	d.Set("message", deployment.Annotations["workers/message"])
+
+	return nil
+}
+
+func resourceCloudflareWorkerDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// deployments cannot be deleted; the latest one stays active until it is
+	// superseded, so removal only drops the state entry
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Worker deployments cannot be deleted",
+			Detail:   fmt.Sprintf("Deployment %q was removed from state but stays active for script %q until superseded by another deployment.", d.Id(), d.Get("script_name").(string)),
+		},
+	}
+}
+
+func resourceCloudflareWorkerDeploymentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id %q specified, should be in format "scriptName/deploymentID"`, d.Id())
+	}
+
+	scriptName, deploymentID := attributes[0], attributes[1]
+
+	d.Set("script_name", scriptName)
+	d.SetId(deploymentID)
+
+	resourceCloudflareWorkerDeploymentRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
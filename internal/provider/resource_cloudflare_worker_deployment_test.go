@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareWorkerDeployment(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_worker_deployment.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkerDeploymentConfig(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "script_name", rnd),
+					resource.TestCheckResourceAttr(name, "version.#", "1"),
+					resource.TestCheckResourceAttr(name, "version.0.percentage", "100"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkerDeploymentConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_worker_script" "%[1]s" {
+		name    = "%[1]s"
+		content = "addEventListener('fetch', event => { event.respondWith(fetch(event.request)) })"
+	}
+
+	resource "cloudflare_worker_version" "%[1]s" {
+		script_name = cloudflare_worker_script.%[1]s.name
+		content     = "addEventListener('fetch', event => { event.respondWith(new Response('v2')) })"
+	}
+
+	resource "cloudflare_worker_deployment" "%[1]s" {
+		script_name = cloudflare_worker_script.%[1]s.name
+
+		version {
+			version_id = cloudflare_worker_version.%[1]s.id
+			percentage = 100
+		}
+	}`, name)
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// workerDomain represents a Worker custom domain attachment as returned by
+// the workers/domains endpoints, which the pinned client does not cover.
+type workerDomain struct {
+	ID          string `json:"id,omitempty"`
+	ZoneID      string `json:"zone_id,omitempty"`
+	ZoneName    string `json:"zone_name,omitempty"`
+	Hostname    string `json:"hostname,omitempty"`
+	Service     string `json:"service,omitempty"`
+	Environment string `json:"environment,omitempty"`
+}
+
+func resourceCloudflareWorkerDomain() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWorkerDomainSchema(),
+		CreateContext: resourceCloudflareWorkerDomainCreate,
+		ReadContext:   resourceCloudflareWorkerDomainRead,
+		UpdateContext: resourceCloudflareWorkerDomainCreate,
+		DeleteContext: resourceCloudflareWorkerDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWorkerDomainImport,
+		},
+		Description: "Provides a resource which attaches a Worker to a custom domain. Unlike a `cloudflare_worker_route`, the DNS record and certificate for the hostname are provisioned automatically.",
+	}
+}
+
+// resourceCloudflareWorkerDomainCreate doubles as the update function: the
+// attach endpoint is an idempotent PUT keyed on the hostname.
+func resourceCloudflareWorkerDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	domain := workerDomain{
+		ZoneID:      d.Get("zone_id").(string),
+		Hostname:    d.Get("hostname").(string),
+		Service:     d.Get("service").(string),
+		Environment: d.Get("environment").(string),
+	}
+
+	res, err := client.Raw("PUT", fmt.Sprintf("/accounts/%s/workers/domains", accountID), domain)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error attaching Worker to domain %q: %w", domain.Hostname, err))
+	}
+
+	var attached workerDomain
+	if err := json.Unmarshal(res, &attached); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Worker domain: %w", err))
+	}
+
+	d.SetId(attached.ID)
+
+	return resourceCloudflareWorkerDomainRead(ctx, d, meta)
+}
+
+func resourceCloudflareWorkerDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw("GET", fmt.Sprintf("/accounts/%s/workers/domains/%s", accountID, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Worker domain %q: %w", d.Id(), err))
+	}
+
+	var domain workerDomain
+	if err := json.Unmarshal(res, &domain); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Worker domain: %w", err))
+	}
+
+	d.Set("zone_id", domain.ZoneID)
+	d.Set("hostname", domain.Hostname)
+	d.Set("service", domain.Service)
+	d.Set("environment", domain.Environment)
+
+	return nil
+}
+
+func resourceCloudflareWorkerDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	_, err := client.Raw("DELETE", fmt.Sprintf("/accounts/%s/workers/domains/%s", accountID, d.Id()), nil)
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error detaching Worker from domain %q: %w", d.Get("hostname").(string), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWorkerDomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/domainID\"", d.Id())
+	}
+
+	accountID, domainID := attributes[0], attributes[1]
+	d.Set("account_id", accountID)
+	d.SetId(domainID)
+
+	resourceCloudflareWorkerDomainRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
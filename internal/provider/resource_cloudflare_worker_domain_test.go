@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareWorkerDomain(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_worker_domain.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckDomain(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkerDomainConfig(rnd, accountID, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "hostname", fmt.Sprintf("%s.%s", rnd, domain)),
+					resource.TestCheckResourceAttr(name, "service", rnd),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkerDomainConfig(name, accountID, zoneID, domain string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_worker_script" "%[1]s" {
+		name    = "%[1]s"
+		content = "addEventListener('fetch', event => { event.respondWith(new Response('ok')) })"
+	}
+
+	resource "cloudflare_worker_domain" "%[1]s" {
+		account_id = "%[2]s"
+		zone_id    = "%[3]s"
+		hostname   = "%[1]s.%[4]s"
+		service    = cloudflare_worker_script.%[1]s.name
+	}`, name, accountID, zoneID, domain)
+}
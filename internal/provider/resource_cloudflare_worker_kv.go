@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -42,6 +43,8 @@ func resourceCloudflareWorkersKVRead(ctx context.Context, d *schema.ResourceData
 		return nil
 	}
 
+	// the read endpoint returns only the value; metadata and expiration are
+	// left as configured
 	d.Set("value", string(value))
 	return nil
 }
@@ -52,8 +55,28 @@ func resourceCloudflareWorkersKVUpdate(ctx context.Context, d *schema.ResourceDa
 	key := d.Get("key").(string)
 	value := d.Get("value").(string)
 
-	_, err := client.WriteWorkersKV(ctx, namespaceID, key, []byte(value))
-	if err != nil {
+	metadataJSON := d.Get("metadata").(string)
+	expirationTTL := d.Get("expiration_ttl").(int)
+
+	if metadataJSON != "" || expirationTTL > 0 {
+		// the single-key write endpoint cannot carry metadata or an
+		// expiration, so keys using either go through the bulk endpoint
+		pair := &cloudflare.WorkersKVPair{
+			Key:           key,
+			Value:         value,
+			ExpirationTTL: expirationTTL,
+		}
+		if metadataJSON != "" {
+			var metadata interface{}
+			if err := json.Unmarshal([]byte(metadataJSON), &metadata); err != nil {
+				return diag.FromErr(errors.Wrap(err, "error parsing workers kv metadata"))
+			}
+			pair.Metadata = metadata
+		}
+		if _, err := client.WriteWorkersKVBulk(ctx, namespaceID, cloudflare.WorkersKVBulkWriteRequest{pair}); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error creating workers kv"))
+		}
+	} else if _, err := client.WriteWorkersKV(ctx, namespaceID, key, []byte(value)); err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating workers kv"))
 	}
 
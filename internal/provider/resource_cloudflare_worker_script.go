@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -21,12 +26,59 @@ func resourceCloudflareWorkerScript() *schema.Resource {
 		ReadContext:   resourceCloudflareWorkerScriptRead,
 		UpdateContext: resourceCloudflareWorkerScriptUpdate,
 		DeleteContext: resourceCloudflareWorkerScriptDelete,
+		CustomizeDiff: resourceCloudflareWorkerScriptDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareWorkerScriptImport,
 		},
 	}
 }
 
+// workerScriptUploadAttributes are the attributes whose changes require
+// re-uploading the script; workers_dev and preview_urls are applied through
+// the separate subdomain endpoint.
+var workerScriptUploadAttributes = []string{
+	"content",
+	"content_sha256",
+	"module",
+	"compatibility_date",
+	"compatibility_flags",
+	"plain_text_binding",
+	"secret_text_binding",
+	"kv_namespace_binding",
+	"webassembly_binding",
+	"service_binding",
+	"r2_bucket_binding",
+	"d1_database_binding",
+	"queue_binding",
+	"durable_object_namespace_binding",
+	"analytics_engine_binding",
+	"dispatch_namespace_binding",
+	"logpush",
+	"tail_consumers",
+	"placement",
+	"additional_module",
+	"source_map",
+}
+
+// resourceCloudflareWorkerScriptDiff makes a pinned content_sha256 digest the
+// change signal for the uploaded content: while the digest stays the same,
+// diffs on the content and module attributes are discarded, so CI pipelines
+// that regenerate identical bundles do not re-upload on every apply.
+func resourceCloudflareWorkerScriptDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.HasChange("content_sha256") {
+		return nil
+	}
+	if d.Get("content_sha256").(string) == "" {
+		return nil
+	}
+	for _, key := range []string{"content", "additional_module", "source_map"} {
+		if err := d.Clear(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type ScriptData struct {
 	// The script id will be the `name` for named script
 	// or the `zone_name` for zone-scoped scripts
@@ -101,6 +153,395 @@ func parseWorkerBindings(d *schema.ResourceData, bindings ScriptBindings) {
 			Environment: cloudflare.StringPtr(data["environment"].(string)),
 		}
 	}
+
+	for _, rawData := range d.Get("r2_bucket_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		bindings[data["name"].(string)] = cloudflare.WorkerR2BucketBinding{
+			BucketName: data["bucket_name"].(string),
+		}
+	}
+
+	for _, rawData := range d.Get("durable_object_namespace_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		bindings[data["name"].(string)] = cloudflare.WorkerDurableObjectBinding{
+			ClassName:  data["class_name"].(string),
+			ScriptName: data["script_name"].(string),
+		}
+	}
+
+	// d1_database_binding, queue_binding and analytics_engine_binding have no
+	// typed equivalent in the pinned client; scripts using them always go
+	// through the multipart upload, which reads them from the schema directly
+}
+
+func parseWorkerSourceMaps(d *schema.ResourceData) map[string]string {
+	sourceMaps := make(map[string]string)
+	for _, rawData := range d.Get("source_map").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		sourceMaps[data["name"].(string)] = data["content"].(string)
+	}
+	return sourceMaps
+}
+
+// workerScriptMainModuleName is the part name the main module is uploaded
+// under for module-syntax workers.
+const workerScriptMainModuleName = "worker.mjs"
+
+// workerModuleContentTypes maps the module types accepted by the upload API
+// to the content type their multipart parts must carry.
+var workerModuleContentTypes = map[string]string{
+	"esm":      "application/javascript+module",
+	"commonjs": "application/javascript",
+	"text":     "text/plain",
+	"data":     "application/octet-stream",
+	"wasm":     "application/wasm",
+}
+
+// workerScriptUsesMultipartUpload reports whether the script must be uploaded
+// with the hand-built multipart body because it uses features the pinned
+// client cannot express: module syntax, additional modules, source maps or
+// compatibility settings.
+func workerScriptUsesMultipartUpload(d *schema.ResourceData) bool {
+	if d.Get("module").(bool) {
+		return true
+	}
+	if d.Get("dispatch_namespace").(string) != "" || d.Get("dispatch_namespace_binding").(*schema.Set).Len() > 0 {
+		return true
+	}
+	if d.Get("additional_module").(*schema.Set).Len() > 0 {
+		return true
+	}
+	if d.Get("source_map").(*schema.Set).Len() > 0 {
+		return true
+	}
+	if _, ok := d.GetOk("compatibility_date"); ok {
+		return true
+	}
+	if d.Get("compatibility_flags").(*schema.Set).Len() > 0 {
+		return true
+	}
+	if d.Get("d1_database_binding").(*schema.Set).Len() > 0 ||
+		d.Get("queue_binding").(*schema.Set).Len() > 0 ||
+		d.Get("analytics_engine_binding").(*schema.Set).Len() > 0 {
+		return true
+	}
+	if d.Get("logpush").(bool) || d.Get("tail_consumers").(*schema.Set).Len() > 0 || len(d.Get("placement").([]interface{})) > 0 {
+		return true
+	}
+	// the typed client cannot express the environment of a durable object
+	// namespace binding
+	for _, rawData := range d.Get("durable_object_namespace_binding").(*schema.Set).List() {
+		if rawData.(map[string]interface{})["environment"].(string) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// formatWorkerScriptMultipartBody mirrors the multipart upload body built by
+// the client's UploadWorkerWithBindings while additionally supporting
+// module-syntax workers, additional modules, compatibility settings and
+// source map parts, which the pinned client cannot express.
+func formatWorkerScriptMultipartBody(d *schema.ResourceData, script string, sourceMaps map[string]string) (string, *bytes.Buffer, error) {
+	buf := &bytes.Buffer{}
+	mpw := multipart.NewWriter(buf)
+	defer mpw.Close()
+
+	meta := struct {
+		BodyPart           string                   `json:"body_part,omitempty"`
+		MainModule         string                   `json:"main_module,omitempty"`
+		CompatibilityDate  string                   `json:"compatibility_date,omitempty"`
+		CompatibilityFlags []string                 `json:"compatibility_flags,omitempty"`
+		Logpush            *bool                    `json:"logpush,omitempty"`
+		TailConsumers      []map[string]string      `json:"tail_consumers,omitempty"`
+		Placement          map[string]string        `json:"placement,omitempty"`
+		Annotations        map[string]string        `json:"annotations,omitempty"`
+		Bindings           []map[string]interface{} `json:"bindings"`
+	}{}
+
+	module := d.Get("module").(bool)
+	if module {
+		meta.MainModule = workerScriptMainModuleName
+	} else {
+		meta.BodyPart = "script"
+	}
+
+	if compatibilityDate, ok := d.GetOk("compatibility_date"); ok {
+		meta.CompatibilityDate = compatibilityDate.(string)
+	}
+	for _, flag := range d.Get("compatibility_flags").(*schema.Set).List() {
+		meta.CompatibilityFlags = append(meta.CompatibilityFlags, flag.(string))
+	}
+
+	if logpush := d.Get("logpush").(bool); logpush {
+		meta.Logpush = &logpush
+	}
+
+	for _, rawData := range d.Get("tail_consumers").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		consumer := map[string]string{
+			"service": data["service"].(string),
+		}
+		if environment := data["environment"].(string); environment != "" {
+			consumer["environment"] = environment
+		}
+		meta.TailConsumers = append(meta.TailConsumers, consumer)
+	}
+
+	if placement, ok := d.GetOk("placement"); ok {
+		meta.Placement = map[string]string{
+			"mode": placement.([]interface{})[0].(map[string]interface{})["mode"].(string),
+		}
+	}
+
+	// only the version upload endpoint accepts annotations; message is not
+	// part of the worker_script schema
+	if message, ok := d.GetOk("message"); ok {
+		meta.Annotations = map[string]string{"workers/message": message.(string)}
+	}
+
+	type wasmPart struct {
+		name   string
+		module string
+	}
+	var wasmParts []wasmPart
+
+	for _, rawData := range d.Get("kv_namespace_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		meta.Bindings = append(meta.Bindings, map[string]interface{}{
+			"name":         data["name"].(string),
+			"type":         "kv_namespace",
+			"namespace_id": data["namespace_id"].(string),
+		})
+	}
+
+	for _, rawData := range d.Get("plain_text_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		meta.Bindings = append(meta.Bindings, map[string]interface{}{
+			"name": data["name"].(string),
+			"type": "plain_text",
+			"text": data["text"].(string),
+		})
+	}
+
+	for _, rawData := range d.Get("secret_text_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		meta.Bindings = append(meta.Bindings, map[string]interface{}{
+			"name": data["name"].(string),
+			"type": "secret_text",
+			"text": data["text"].(string),
+		})
+	}
+
+	for _, rawData := range d.Get("webassembly_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		name := data["name"].(string)
+		partName := name + "_wasm_module"
+		meta.Bindings = append(meta.Bindings, map[string]interface{}{
+			"name": name,
+			"type": "wasm_module",
+			"part": partName,
+		})
+		wasmParts = append(wasmParts, wasmPart{name: partName, module: data["module"].(string)})
+	}
+
+	for _, rawData := range d.Get("service_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		meta.Bindings = append(meta.Bindings, map[string]interface{}{
+			"name":        data["name"].(string),
+			"type":        "service",
+			"service":     data["service"].(string),
+			"environment": data["environment"].(string),
+		})
+	}
+
+	for _, rawData := range d.Get("r2_bucket_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		meta.Bindings = append(meta.Bindings, map[string]interface{}{
+			"name":        data["name"].(string),
+			"type":        "r2_bucket",
+			"bucket_name": data["bucket_name"].(string),
+		})
+	}
+
+	for _, rawData := range d.Get("d1_database_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		meta.Bindings = append(meta.Bindings, map[string]interface{}{
+			"name": data["name"].(string),
+			"type": "d1",
+			"id":   data["database_id"].(string),
+		})
+	}
+
+	for _, rawData := range d.Get("queue_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		meta.Bindings = append(meta.Bindings, map[string]interface{}{
+			"name":       data["name"].(string),
+			"type":       "queue",
+			"queue_name": data["queue"].(string),
+		})
+	}
+
+	for _, rawData := range d.Get("durable_object_namespace_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		binding := map[string]interface{}{
+			"name":       data["name"].(string),
+			"type":       "durable_object_namespace",
+			"class_name": data["class_name"].(string),
+		}
+		if scriptName := data["script_name"].(string); scriptName != "" {
+			binding["script_name"] = scriptName
+		}
+		if environment := data["environment"].(string); environment != "" {
+			binding["environment"] = environment
+		}
+		meta.Bindings = append(meta.Bindings, binding)
+	}
+
+	for _, rawData := range d.Get("analytics_engine_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		meta.Bindings = append(meta.Bindings, map[string]interface{}{
+			"name":    data["name"].(string),
+			"type":    "analytics_engine",
+			"dataset": data["dataset"].(string),
+		})
+	}
+
+	for _, rawData := range d.Get("dispatch_namespace_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		meta.Bindings = append(meta.Bindings, map[string]interface{}{
+			"name":      data["name"].(string),
+			"type":      "dispatch_namespace",
+			"namespace": data["namespace"].(string),
+		})
+	}
+
+	if meta.Bindings == nil {
+		meta.Bindings = []map[string]interface{}{}
+	}
+
+	hdr := textproto.MIMEHeader{}
+	hdr.Set("content-disposition", `form-data; name="metadata"`)
+	hdr.Set("content-type", "application/json")
+	pw, err := mpw.CreatePart(hdr)
+	if err != nil {
+		return "", nil, err
+	}
+	metaJSON, err := json.Marshal(meta)
+	if err != nil {
+		return "", nil, err
+	}
+	if _, err := pw.Write(metaJSON); err != nil {
+		return "", nil, err
+	}
+
+	hdr = textproto.MIMEHeader{}
+	if module {
+		hdr.Set("content-disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, workerScriptMainModuleName, workerScriptMainModuleName))
+		hdr.Set("content-type", workerModuleContentTypes["esm"])
+	} else {
+		hdr.Set("content-disposition", `form-data; name="script"`)
+		hdr.Set("content-type", "application/javascript")
+	}
+	pw, err = mpw.CreatePart(hdr)
+	if err != nil {
+		return "", nil, err
+	}
+	if _, err := pw.Write([]byte(script)); err != nil {
+		return "", nil, err
+	}
+
+	for _, rawData := range d.Get("additional_module").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		name := data["name"].(string)
+		moduleType := data["type"].(string)
+
+		content := []byte(data["content"].(string))
+		if moduleType == "wasm" || moduleType == "data" {
+			var err error
+			if content, err = base64.StdEncoding.DecodeString(data["content"].(string)); err != nil {
+				return "", nil, fmt.Errorf("cannot decode content of %s module %q: %w", moduleType, name, err)
+			}
+		}
+
+		hdr = textproto.MIMEHeader{}
+		hdr.Set("content-disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, name, name))
+		hdr.Set("content-type", workerModuleContentTypes[moduleType])
+		pw, err = mpw.CreatePart(hdr)
+		if err != nil {
+			return "", nil, err
+		}
+		if _, err := pw.Write(content); err != nil {
+			return "", nil, err
+		}
+	}
+
+	for _, part := range wasmParts {
+		module, err := base64.StdEncoding.DecodeString(part.module)
+		if err != nil {
+			return "", nil, fmt.Errorf("cannot decode wasm module for part %q: %w", part.name, err)
+		}
+		hdr = textproto.MIMEHeader{}
+		hdr.Set("content-disposition", fmt.Sprintf(`form-data; name="%s"`, part.name))
+		hdr.Set("content-type", "application/wasm")
+		pw, err = mpw.CreatePart(hdr)
+		if err != nil {
+			return "", nil, err
+		}
+		if _, err := pw.Write(module); err != nil {
+			return "", nil, err
+		}
+	}
+
+	for name, content := range sourceMaps {
+		hdr = textproto.MIMEHeader{}
+		hdr.Set("content-disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, name, name))
+		hdr.Set("content-type", "application/source-map")
+		pw, err = mpw.CreatePart(hdr)
+		if err != nil {
+			return "", nil, err
+		}
+		if _, err := pw.Write([]byte(content)); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return mpw.FormDataContentType(), buf, nil
+}
+
+// uploadWorkerScriptMultipart uploads the script, bindings, modules and
+// source maps in a single multipart request, matching the endpoint the client
+// uses for named scripts.
+func uploadWorkerScriptMultipart(ctx context.Context, client *cloudflare.API, scriptName string, d *schema.ResourceData, script string) error {
+	if client.AccountID == "" {
+		return fmt.Errorf("account ID required to upload modules, source maps or compatibility settings")
+	}
+
+	contentType, body, err := formatWorkerScriptMultipartBody(d, script, parseWorkerSourceMaps(d))
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("/accounts/%s/workers/scripts/%s", client.AccountID, scriptName)
+	if namespace := d.Get("dispatch_namespace").(string); namespace != "" {
+		endpoint = fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces/%s/scripts/%s", client.AccountID, namespace, scriptName)
+	}
+	res, err := doAuthenticatedHTTPRequest(ctx, client, http.MethodPut, endpoint, contentType, body)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP status %d: %s", res.StatusCode, string(resBody))
+	}
+
+	return nil
 }
 
 func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -111,10 +552,14 @@ func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	// make sure that the worker does not already exist
-	r, _ := client.DownloadWorker(ctx, &scriptData.Params)
-	if r.WorkerScript.Script != "" {
-		return diag.FromErr(fmt.Errorf("script already exists"))
+	// make sure that the worker does not already exist; scripts in a dispatch
+	// namespace live outside the account-level namespace the typed client
+	// downloads from, so the check only applies to account-level scripts
+	if d.Get("dispatch_namespace").(string) == "" {
+		r, _ := client.DownloadWorker(ctx, &scriptData.Params)
+		if r.WorkerScript.Script != "" {
+			return diag.FromErr(fmt.Errorf("script already exists"))
+		}
 	}
 
 	scriptBody := d.Get("content").(string)
@@ -128,21 +573,45 @@ func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.Resourc
 
 	parseWorkerBindings(d, bindings)
 
-	scriptParams := cloudflare.WorkerScriptParams{
-		Script:   scriptBody,
-		Bindings: bindings,
-	}
+	if workerScriptUsesMultipartUpload(d) {
+		if err := uploadWorkerScriptMultipart(ctx, client, scriptData.ID, d, scriptBody); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error creating worker script"))
+		}
+	} else {
+		scriptParams := cloudflare.WorkerScriptParams{
+			Script:   scriptBody,
+			Bindings: bindings,
+		}
 
-	_, err = client.UploadWorkerWithBindings(ctx, &scriptData.Params, &scriptParams)
-	if err != nil {
-		return diag.FromErr(errors.Wrap(err, "error creating worker script"))
+		_, err = client.UploadWorkerWithBindings(ctx, &scriptData.Params, &scriptParams)
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, "error creating worker script"))
+		}
 	}
 
 	d.SetId(scriptData.ID)
 
+	// both settings default to enabled on the API side, so a call is only
+	// needed when the configuration opts out
+	if d.Get("dispatch_namespace").(string) == "" && (!d.Get("workers_dev").(bool) || !d.Get("preview_urls").(bool)) {
+		if err := setWorkerScriptSubdomainSettings(client, scriptData.ID, d); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error updating worker script subdomain settings"))
+		}
+	}
+
 	return nil
 }
 
+// setWorkerScriptSubdomainSettings toggles serving the script on the
+// account's workers.dev subdomain and its preview URLs.
+func setWorkerScriptSubdomainSettings(client *cloudflare.API, scriptName string, d *schema.ResourceData) error {
+	_, err := client.Raw(http.MethodPost, fmt.Sprintf("/accounts/%s/workers/scripts/%s/subdomain", client.AccountID, scriptName), map[string]interface{}{
+		"enabled":          d.Get("workers_dev").(bool),
+		"previews_enabled": d.Get("preview_urls").(bool),
+	})
+	return err
+}
+
 func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
@@ -151,6 +620,21 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	// scripts in a dispatch namespace are not reachable through the typed
+	// client; existence is checked on the namespaced endpoint and the content
+	// and bindings are left as configured
+	if namespace := d.Get("dispatch_namespace").(string); namespace != "" {
+		_, err := client.Raw("GET", fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces/%s/scripts/%s", client.AccountID, namespace, scriptData.ID), nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "HTTP status 404") {
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("Error reading namespaced worker script %q", scriptData.ID)))
+		}
+		return nil
+	}
+
 	r, err := client.DownloadWorker(ctx, &scriptData.Params)
 	if err != nil {
 		// If the resource is deleted, we should set the ID to "" and not
@@ -164,6 +648,20 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 			fmt.Sprintf("Error reading worker script from API for resource %+v", &scriptData.Params)))
 	}
 
+	if res, err := rawConditionalGet(ctx, client, fmt.Sprintf("/accounts/%s/workers/scripts/%s/subdomain", client.AccountID, scriptData.ID)); err == nil {
+		var subdomainSettings struct {
+			Enabled         bool `json:"enabled"`
+			PreviewsEnabled bool `json:"previews_enabled"`
+		}
+		if err := json.Unmarshal(res, &subdomainSettings); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error unmarshalling worker script subdomain settings"))
+		}
+		d.Set("workers_dev", subdomainSettings.Enabled)
+		d.Set("preview_urls", subdomainSettings.PreviewsEnabled)
+	} else if !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(errors.Wrap(err, "error reading worker script subdomain settings"))
+	}
+
 	existingBindings := make(ScriptBindings)
 
 	parseWorkerBindings(d, existingBindings)
@@ -178,6 +676,16 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 	secretTextBindings := &schema.Set{F: schema.HashResource(secretTextBindingResource)}
 	webAssemblyBindings := &schema.Set{F: schema.HashResource(webAssemblyBindingResource)}
 	serviceBindings := &schema.Set{F: schema.HashResource(serviceBindingResource)}
+	r2BucketBindings := &schema.Set{F: schema.HashResource(r2BucketBindingResource)}
+	durableObjectBindings := &schema.Set{F: schema.HashResource(durableObjectNamespaceBindingResource)}
+
+	// the bindings endpoint does not return the environment of a durable
+	// object namespace binding, so it is carried over from the configuration
+	durableObjectEnvironments := make(map[string]string)
+	for _, rawData := range d.Get("durable_object_namespace_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		durableObjectEnvironments[data["name"].(string)] = data["environment"].(string)
+	}
 
 	for name, binding := range bindings {
 		switch v := binding.(type) {
@@ -216,11 +724,27 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 				"service":     v.Service,
 				"environment": cloudflare.String(v.Environment),
 			})
+		case cloudflare.WorkerR2BucketBinding:
+			r2BucketBindings.Add(map[string]interface{}{
+				"name":        name,
+				"bucket_name": v.BucketName,
+			})
+		case cloudflare.WorkerDurableObjectBinding:
+			durableObjectBindings.Add(map[string]interface{}{
+				"name":        name,
+				"class_name":  v.ClassName,
+				"script_name": v.ScriptName,
+				"environment": durableObjectEnvironments[name],
+			})
 		}
 	}
 
-	if err := d.Set("content", r.Script); err != nil {
-		return diag.FromErr(fmt.Errorf("cannot set content: %w", err))
+	// the download endpoint returns a multipart document for module-syntax
+	// workers, not the bare main module, so content is left as configured
+	if !d.Get("module").(bool) {
+		if err := d.Set("content", r.Script); err != nil {
+			return diag.FromErr(fmt.Errorf("cannot set content: %w", err))
+		}
 	}
 
 	if err := d.Set("kv_namespace_binding", kvNamespaceBindings); err != nil {
@@ -243,6 +767,14 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("cannot set service bindings (%s): %w", d.Id(), err))
 	}
 
+	if err := d.Set("r2_bucket_binding", r2BucketBindings); err != nil {
+		return diag.FromErr(fmt.Errorf("cannot set r2 bucket bindings (%s): %w", d.Id(), err))
+	}
+
+	if err := d.Set("durable_object_namespace_binding", durableObjectBindings); err != nil {
+		return diag.FromErr(fmt.Errorf("cannot set durable object namespace bindings (%s): %w", d.Id(), err))
+	}
+
 	return nil
 }
 
@@ -259,20 +791,36 @@ func resourceCloudflareWorkerScriptUpdate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(fmt.Errorf("script content cannot be empty"))
 	}
 
-	tflog.Info(ctx, fmt.Sprintf("Updating Cloudflare Worker Script from struct: %+v", &scriptData.Params))
+	if d.HasChanges(workerScriptUploadAttributes...) {
+		tflog.Info(ctx, fmt.Sprintf("Updating Cloudflare Worker Script from struct: %+v", &scriptData.Params))
 
-	bindings := make(ScriptBindings)
+		bindings := make(ScriptBindings)
 
-	parseWorkerBindings(d, bindings)
+		parseWorkerBindings(d, bindings)
 
-	scriptParams := cloudflare.WorkerScriptParams{
-		Script:   scriptBody,
-		Bindings: bindings,
+		if workerScriptUsesMultipartUpload(d) {
+			if err := uploadWorkerScriptMultipart(ctx, client, scriptData.ID, d, scriptBody); err != nil {
+				return diag.FromErr(errors.Wrap(err, "error updating worker script"))
+			}
+		} else {
+			scriptParams := cloudflare.WorkerScriptParams{
+				Script:   scriptBody,
+				Bindings: bindings,
+			}
+
+			_, err = client.UploadWorkerWithBindings(ctx, &scriptData.Params, &scriptParams)
+			if err != nil {
+				return diag.FromErr(errors.Wrap(err, "error updating worker script"))
+			}
+		}
+	} else {
+		tflog.Info(ctx, fmt.Sprintf("Skipping upload of Cloudflare Worker Script %q: no content changes", scriptData.ID))
 	}
 
-	_, err = client.UploadWorkerWithBindings(ctx, &scriptData.Params, &scriptParams)
-	if err != nil {
-		return diag.FromErr(errors.Wrap(err, "error updating worker script"))
+	if d.Get("dispatch_namespace").(string) == "" && d.HasChanges("workers_dev", "preview_urls") {
+		if err := setWorkerScriptSubdomainSettings(client, scriptData.ID, d); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error updating worker script subdomain settings"))
+		}
 	}
 
 	return nil
@@ -288,6 +836,14 @@ func resourceCloudflareWorkerScriptDelete(ctx context.Context, d *schema.Resourc
 
 	tflog.Info(ctx, fmt.Sprintf("Deleting Cloudflare Worker Script from struct: %+v", &scriptData.Params))
 
+	if namespace := d.Get("dispatch_namespace").(string); namespace != "" {
+		_, err := client.Raw("DELETE", fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces/%s/scripts/%s", client.AccountID, namespace, scriptData.ID), nil)
+		if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+			return diag.FromErr(errors.Wrap(err, "error deleting namespaced worker script"))
+		}
+		return nil
+	}
+
 	_, err = client.DeleteWorker(ctx, &scriptData.Params)
 	if err != nil {
 		// If the resource is already deleted, we should return without an error
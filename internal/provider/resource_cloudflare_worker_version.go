@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// workerVersion is the API representation of an uploaded worker version. The
+// upload payload itself is the same multipart body as a script upload.
+type workerVersion struct {
+	ID          string            `json:"id"`
+	Number      int               `json:"number"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+func resourceCloudflareWorkerVersion() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWorkerVersionSchema(),
+		CreateContext: resourceCloudflareWorkerVersionCreate,
+		ReadContext:   resourceCloudflareWorkerVersionRead,
+		DeleteContext: resourceCloudflareWorkerVersionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWorkerVersionImport,
+		},
+		Description: "Provides a resource which uploads a version of a worker script without activating it. Versions are activated, optionally gradually, with `cloudflare_worker_deployment`.",
+	}
+}
+
+func workerVersionEndpoint(accountID, scriptName, versionID string) string {
+	endpoint := fmt.Sprintf("/accounts/%s/workers/scripts/%s/versions", accountID, scriptName)
+	if versionID != "" {
+		endpoint = fmt.Sprintf("%s/%s", endpoint, versionID)
+	}
+	return endpoint
+}
+
+func resourceCloudflareWorkerVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	if client.AccountID == "" {
+		return diag.FromErr(fmt.Errorf("account ID is required to upload worker versions"))
+	}
+	scriptName := d.Get("script_name").(string)
+
+	contentType, body, err := formatWorkerScriptMultipartBody(d, d.Get("content").(string), parseWorkerSourceMaps(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error building worker version upload body: %w", err))
+	}
+
+	res, err := doAuthenticatedHTTPRequest(ctx, client, http.MethodPost, workerVersionEndpoint(client.AccountID, scriptName, ""), contentType, body)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error uploading worker version: %w", err))
+	}
+	defer res.Body.Close()
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error uploading worker version: %w", err))
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return diag.FromErr(fmt.Errorf("error uploading worker version: HTTP status %d: %s", res.StatusCode, string(resBody)))
+	}
+
+	var envelope struct {
+		Result workerVersion `json:"result"`
+	}
+	if err := json.Unmarshal(resBody, &envelope); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling worker version: %w", err))
+	}
+
+	d.SetId(envelope.Result.ID)
+
+	return resourceCloudflareWorkerVersionRead(ctx, d, meta)
+}
+
+func resourceCloudflareWorkerVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	scriptName := d.Get("script_name").(string)
+
+	res, err := client.Raw(http.MethodGet, workerVersionEndpoint(client.AccountID, scriptName, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading worker version %q: %w", d.Id(), err))
+	}
+
+	var version workerVersion
+	if err := json.Unmarshal(res, &version); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling worker version: %w", err))
+	}
+
+	d.Set("number", version.Number)
+	d.Set("message", version.Annotations["workers/message"])
+
+	return nil
+}
+
+func resourceCloudflareWorkerVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// versions cannot be deleted through the API; they are retained with the
+	// script and pruned by the service, so removal only drops the state entry
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Worker versions cannot be deleted",
+			Detail:   fmt.Sprintf("Version %q was removed from state but remains in the version history of script %q until pruned by Cloudflare.", d.Id(), d.Get("script_name").(string)),
+		},
+	}
+}
+
+func resourceCloudflareWorkerVersionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id %q specified, should be in format "scriptName/versionID"`, d.Id())
+	}
+
+	scriptName, versionID := attributes[0], attributes[1]
+
+	d.Set("script_name", scriptName)
+	d.SetId(versionID)
+
+	resourceCloudflareWorkerVersionRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
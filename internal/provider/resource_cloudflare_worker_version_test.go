@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareWorkerVersion(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_worker_version.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkerVersionConfig(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "script_name", rnd),
+					resource.TestCheckResourceAttr(name, "message", "initial version"),
+					resource.TestCheckResourceAttrSet(name, "number"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkerVersionConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_worker_script" "%[1]s" {
+		name    = "%[1]s"
+		content = "addEventListener('fetch', event => { event.respondWith(fetch(event.request)) })"
+	}
+
+	resource "cloudflare_worker_version" "%[1]s" {
+		script_name = cloudflare_worker_script.%[1]s.name
+		content     = "addEventListener('fetch', event => { event.respondWith(new Response('v2')) })"
+		message     = "initial version"
+	}`, name)
+}
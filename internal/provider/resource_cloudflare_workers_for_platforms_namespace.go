@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// workersDispatchNamespace represents a Workers for Platforms dispatch
+// namespace as returned by the workers/dispatch/namespaces endpoints, which
+// the pinned client does not cover.
+type workersDispatchNamespace struct {
+	NamespaceID   string `json:"namespace_id,omitempty"`
+	NamespaceName string `json:"namespace_name,omitempty"`
+}
+
+func resourceCloudflareWorkersForPlatformsNamespace() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWorkersForPlatformsNamespaceSchema(),
+		CreateContext: resourceCloudflareWorkersForPlatformsNamespaceCreate,
+		ReadContext:   resourceCloudflareWorkersForPlatformsNamespaceRead,
+		DeleteContext: resourceCloudflareWorkersForPlatformsNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWorkersForPlatformsNamespaceImport,
+		},
+		Description: "Provides a resource which manages a Workers for Platforms dispatch namespace. User Workers uploaded into the namespace are invoked from a dispatcher Worker through a `dispatch_namespace_binding`.",
+	}
+}
+
+func resourceCloudflareWorkersForPlatformsNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+
+	res, err := client.Raw("POST", fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces", accountID), map[string]interface{}{
+		"name": name,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating dispatch namespace %q: %w", name, err))
+	}
+
+	var namespace workersDispatchNamespace
+	if err := json.Unmarshal(res, &namespace); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling dispatch namespace: %w", err))
+	}
+
+	d.SetId(namespace.NamespaceName)
+
+	return resourceCloudflareWorkersForPlatformsNamespaceRead(ctx, d, meta)
+}
+
+func resourceCloudflareWorkersForPlatformsNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw("GET", fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces/%s", accountID, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading dispatch namespace %q: %w", d.Id(), err))
+	}
+
+	var namespace workersDispatchNamespace
+	if err := json.Unmarshal(res, &namespace); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling dispatch namespace: %w", err))
+	}
+
+	d.Set("name", namespace.NamespaceName)
+	d.Set("namespace_id", namespace.NamespaceID)
+
+	return nil
+}
+
+func resourceCloudflareWorkersForPlatformsNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	_, err := client.Raw("DELETE", fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces/%s", accountID, d.Id()), nil)
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error deleting dispatch namespace %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWorkersForPlatformsNamespaceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/namespaceName\"", d.Id())
+	}
+
+	accountID, namespaceName := attributes[0], attributes[1]
+	d.Set("account_id", accountID)
+	d.SetId(namespaceName)
+
+	resourceCloudflareWorkersForPlatformsNamespaceRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
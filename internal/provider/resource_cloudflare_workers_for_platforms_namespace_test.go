@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareWorkersForPlatformsNamespace(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_workers_for_platforms_namespace.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkersForPlatformsNamespaceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttrSet(name, "namespace_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkersForPlatformsNamespaceConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_workers_for_platforms_namespace" "%[1]s" {
+		account_id = "%[2]s"
+		name       = "%[1]s"
+	}`, name, accountID)
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// workersKVBulkBatchSize is the maximum number of pairs the bulk write and
+// bulk delete endpoints accept per request.
+const workersKVBulkBatchSize = 10000
+
+func resourceCloudflareWorkersKVBulk() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWorkersKVBulkSchema(),
+		CreateContext: resourceCloudflareWorkersKVBulkCreate,
+		ReadContext:   resourceCloudflareWorkersKVBulkRead,
+		UpdateContext: resourceCloudflareWorkersKVBulkUpdate,
+		DeleteContext: resourceCloudflareWorkersKVBulkDelete,
+		Description:   "Provides a resource which writes a map of Workers KV pairs in batched bulk API calls, suitable for seeding a namespace with a large number of entries.",
+	}
+}
+
+func writeWorkersKVBulkPairs(ctx context.Context, client *cloudflare.API, namespaceID string, pairs map[string]interface{}, expirationTTL int) error {
+	keys := make([]string, 0, len(pairs))
+	for key := range pairs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	request := make(cloudflare.WorkersKVBulkWriteRequest, 0, len(keys))
+	for _, key := range keys {
+		request = append(request, &cloudflare.WorkersKVPair{
+			Key:           key,
+			Value:         pairs[key].(string),
+			ExpirationTTL: expirationTTL,
+		})
+	}
+
+	for start := 0; start < len(request); start += workersKVBulkBatchSize {
+		end := start + workersKVBulkBatchSize
+		if end > len(request) {
+			end = len(request)
+		}
+		if _, err := client.WriteWorkersKVBulk(ctx, namespaceID, request[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteWorkersKVBulkKeys(ctx context.Context, client *cloudflare.API, namespaceID string, keys []string) error {
+	sort.Strings(keys)
+
+	for start := 0; start < len(keys); start += workersKVBulkBatchSize {
+		end := start + workersKVBulkBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		if _, err := client.DeleteWorkersKVBulk(ctx, namespaceID, keys[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceCloudflareWorkersKVBulkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	namespaceID := d.Get("namespace_id").(string)
+	pairs := d.Get("pairs").(map[string]interface{})
+
+	tflog.Info(ctx, fmt.Sprintf("Writing %d Workers KV pairs into namespace %s", len(pairs), namespaceID))
+
+	if err := writeWorkersKVBulkPairs(ctx, client, namespaceID, pairs, d.Get("expiration_ttl").(int)); err != nil {
+		return diag.FromErr(errors.Wrap(err, "error writing workers kv pairs"))
+	}
+
+	d.SetId(namespaceID)
+
+	return resourceCloudflareWorkersKVBulkRead(ctx, d, meta)
+}
+
+func resourceCloudflareWorkersKVBulkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	namespaceID := d.Id()
+
+	// reading thousands of values individually would be prohibitively slow,
+	// so drift detection is limited to key existence: pairs whose key has
+	// disappeared from the namespace are dropped so they get rewritten
+	existing := make(map[string]bool)
+	options := cloudflare.ListWorkersKVsOptions{}
+	for {
+		resp, err := client.ListWorkersKVsWithOptions(ctx, namespaceID, options)
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, "error listing workers kv keys"))
+		}
+		for _, key := range resp.Result {
+			existing[key.Name] = true
+		}
+		if resp.Cursor == "" {
+			break
+		}
+		options.Cursor = cloudflare.StringPtr(resp.Cursor)
+	}
+
+	pairs := make(map[string]interface{})
+	for key, value := range d.Get("pairs").(map[string]interface{}) {
+		if existing[key] {
+			pairs[key] = value
+		}
+	}
+
+	d.Set("pairs", pairs)
+
+	return nil
+}
+
+func resourceCloudflareWorkersKVBulkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	namespaceID := d.Get("namespace_id").(string)
+
+	oldRaw, newRaw := d.GetChange("pairs")
+	oldPairs := oldRaw.(map[string]interface{})
+	newPairs := newRaw.(map[string]interface{})
+
+	var removed []string
+	for key := range oldPairs {
+		if _, ok := newPairs[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	// a TTL change has to be reapplied to every key, otherwise only new and
+	// changed values are rewritten
+	changed := newPairs
+	if !d.HasChange("expiration_ttl") {
+		changed = make(map[string]interface{})
+		for key, value := range newPairs {
+			if oldValue, ok := oldPairs[key]; !ok || oldValue != value {
+				changed[key] = value
+			}
+		}
+	}
+
+	if len(removed) > 0 {
+		if err := deleteWorkersKVBulkKeys(ctx, client, namespaceID, removed); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error deleting workers kv pairs"))
+		}
+	}
+
+	if len(changed) > 0 {
+		if err := writeWorkersKVBulkPairs(ctx, client, namespaceID, changed, d.Get("expiration_ttl").(int)); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error writing workers kv pairs"))
+		}
+	}
+
+	return resourceCloudflareWorkersKVBulkRead(ctx, d, meta)
+}
+
+func resourceCloudflareWorkersKVBulkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	namespaceID := d.Get("namespace_id").(string)
+	pairs := d.Get("pairs").(map[string]interface{})
+
+	keys := make([]string, 0, len(pairs))
+	for key := range pairs {
+		keys = append(keys, key)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting %d Workers KV pairs from namespace %s", len(keys), namespaceID))
+
+	if err := deleteWorkersKVBulkKeys(ctx, client, namespaceID, keys); err != nil {
+		return diag.FromErr(errors.Wrap(err, "error deleting workers kv pairs"))
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareWorkersKVBulk(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_workers_kv_bulk.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkersKVBulkConfig(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "pairs.%", "2"),
+					resource.TestCheckResourceAttr(name, "pairs.first", "one"),
+					resource.TestCheckResourceAttr(name, "pairs.second", "two"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkersKVBulkConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_workers_kv_namespace" "%[1]s" {
+		title = "%[1]s"
+	}
+
+	resource "cloudflare_workers_kv_bulk" "%[1]s" {
+		namespace_id = cloudflare_workers_kv_namespace.%[1]s.id
+
+		pairs = {
+			first  = "one"
+			second = "two"
+		}
+	}`, name)
+}
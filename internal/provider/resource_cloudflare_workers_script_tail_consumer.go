@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// workersTailConsumer is one entry of the tail_consumers list in the
+// script settings of a Worker.
+type workersTailConsumer struct {
+	Service     string `json:"service"`
+	Environment string `json:"environment,omitempty"`
+}
+
+func resourceCloudflareWorkersScriptTailConsumer() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWorkersScriptTailConsumerSchema(),
+		CreateContext: resourceCloudflareWorkersScriptTailConsumerCreate,
+		ReadContext:   resourceCloudflareWorkersScriptTailConsumerRead,
+		DeleteContext: resourceCloudflareWorkersScriptTailConsumerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWorkersScriptTailConsumerImport,
+		},
+		Description: "Provides a resource which attaches a tail Worker to a producer Worker script, so logging pipelines can be managed separately from the scripts they observe.",
+	}
+}
+
+func workersScriptSettingsEndpoint(accountID, scriptName string) string {
+	return fmt.Sprintf("/accounts/%s/workers/scripts/%s/script-settings", accountID, scriptName)
+}
+
+func getWorkersScriptTailConsumers(ctx context.Context, client *cloudflare.API, accountID, scriptName string) ([]workersTailConsumer, error) {
+	res, err := rawConditionalGet(ctx, client, workersScriptSettingsEndpoint(accountID, scriptName))
+	if err != nil {
+		return nil, err
+	}
+
+	var settings struct {
+		TailConsumers []workersTailConsumer `json:"tail_consumers"`
+	}
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return nil, fmt.Errorf("error unmarshalling script settings: %w", err)
+	}
+
+	return settings.TailConsumers, nil
+}
+
+func setWorkersScriptTailConsumers(client *cloudflare.API, accountID, scriptName string, consumers []workersTailConsumer) error {
+	if consumers == nil {
+		consumers = []workersTailConsumer{}
+	}
+	_, err := client.Raw(http.MethodPatch, workersScriptSettingsEndpoint(accountID, scriptName), map[string]interface{}{
+		"tail_consumers": consumers,
+	})
+	return err
+}
+
+func workersScriptTailConsumerID(scriptName string, consumer workersTailConsumer) string {
+	return stringChecksum(fmt.Sprintf("tail-consumer/%s/%s/%s", scriptName, consumer.Service, consumer.Environment))
+}
+
+func resourceCloudflareWorkersScriptTailConsumerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	scriptName := d.Get("script_name").(string)
+	consumer := workersTailConsumer{
+		Service:     d.Get("service").(string),
+		Environment: d.Get("environment").(string),
+	}
+
+	consumers, err := getWorkersScriptTailConsumers(ctx, client, accountID, scriptName)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading tail consumers of script %q: %w", scriptName, err))
+	}
+
+	attached := false
+	for _, existing := range consumers {
+		if existing == consumer {
+			attached = true
+			break
+		}
+	}
+
+	if !attached {
+		if err := setWorkersScriptTailConsumers(client, accountID, scriptName, append(consumers, consumer)); err != nil {
+			return diag.FromErr(fmt.Errorf("error attaching tail consumer %q to script %q: %w", consumer.Service, scriptName, err))
+		}
+	}
+
+	d.SetId(workersScriptTailConsumerID(scriptName, consumer))
+
+	return resourceCloudflareWorkersScriptTailConsumerRead(ctx, d, meta)
+}
+
+func resourceCloudflareWorkersScriptTailConsumerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	scriptName := d.Get("script_name").(string)
+	consumer := workersTailConsumer{
+		Service:     d.Get("service").(string),
+		Environment: d.Get("environment").(string),
+	}
+
+	consumers, err := getWorkersScriptTailConsumers(ctx, client, accountID, scriptName)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading tail consumers of script %q: %w", scriptName, err))
+	}
+
+	for _, existing := range consumers {
+		if existing == consumer {
+			return nil
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudflareWorkersScriptTailConsumerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	scriptName := d.Get("script_name").(string)
+	consumer := workersTailConsumer{
+		Service:     d.Get("service").(string),
+		Environment: d.Get("environment").(string),
+	}
+
+	consumers, err := getWorkersScriptTailConsumers(ctx, client, accountID, scriptName)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading tail consumers of script %q: %w", scriptName, err))
+	}
+
+	remaining := []workersTailConsumer{}
+	for _, existing := range consumers {
+		if existing != consumer {
+			remaining = append(remaining, existing)
+		}
+	}
+
+	if len(remaining) != len(consumers) {
+		if err := setWorkersScriptTailConsumers(client, accountID, scriptName, remaining); err != nil {
+			return diag.FromErr(fmt.Errorf("error detaching tail consumer %q from script %q: %w", consumer.Service, scriptName, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceCloudflareWorkersScriptTailConsumerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 4)
+
+	if len(attributes) < 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/scriptName/service\" or \"accountID/scriptName/service/environment\"", d.Id())
+	}
+
+	consumer := workersTailConsumer{Service: attributes[2]}
+	if len(attributes) == 4 {
+		consumer.Environment = attributes[3]
+	}
+
+	d.Set("account_id", attributes[0])
+	d.Set("script_name", attributes[1])
+	d.Set("service", consumer.Service)
+	d.Set("environment", consumer.Environment)
+	d.SetId(workersScriptTailConsumerID(attributes[1], consumer))
+
+	resourceCloudflareWorkersScriptTailConsumerRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
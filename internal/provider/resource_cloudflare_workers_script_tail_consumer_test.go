@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareWorkersScriptTailConsumer(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_workers_script_tail_consumer.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkersScriptTailConsumerConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "script_name", rnd+"-producer"),
+					resource.TestCheckResourceAttr(name, "service", rnd+"-tail"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkersScriptTailConsumerConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_worker_script" "%[1]s_producer" {
+		name    = "%[1]s-producer"
+		content = "addEventListener('fetch', event => { event.respondWith(new Response('ok')) })"
+	}
+
+	resource "cloudflare_worker_script" "%[1]s_tail" {
+		name    = "%[1]s-tail"
+		content = "export default { async tail(events) {} }"
+		module  = true
+	}
+
+	resource "cloudflare_workers_script_tail_consumer" "%[1]s" {
+		account_id  = "%[2]s"
+		script_name = cloudflare_worker_script.%[1]s_producer.name
+		service     = cloudflare_worker_script.%[1]s_tail.name
+	}`, name, accountID)
+}
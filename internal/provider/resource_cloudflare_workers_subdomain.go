@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// workersSubdomain is the API representation of the account's workers.dev
+// subdomain.
+type workersSubdomain struct {
+	Subdomain string `json:"subdomain"`
+}
+
+func resourceCloudflareWorkersSubdomain() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"subdomain": {
+				Description:  "The name of the `workers.dev` subdomain of the account, without the `.workers.dev` suffix.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[a-z0-9][a-z0-9-]*$"), "subdomain must consist of lowercase letters, digits and hyphens"),
+			},
+		},
+		CreateContext: resourceCloudflareWorkersSubdomainCreate,
+		ReadContext:   resourceCloudflareWorkersSubdomainRead,
+		UpdateContext: resourceCloudflareWorkersSubdomainCreate,
+		DeleteContext: resourceCloudflareWorkersSubdomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWorkersSubdomainImport,
+		},
+		Description: "Provides a resource which manages the `workers.dev` subdomain of an account, on which Worker scripts with `workers_dev` enabled are served.",
+	}
+}
+
+func workersSubdomainEndpoint(accountID string) string {
+	return fmt.Sprintf("/accounts/%s/workers/subdomain", accountID)
+}
+
+func resourceCloudflareWorkersSubdomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	subdomain := d.Get("subdomain").(string)
+
+	_, err := client.Raw(http.MethodPut, workersSubdomainEndpoint(accountID), workersSubdomain{Subdomain: subdomain})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error setting workers.dev subdomain to %q: %w", subdomain, err))
+	}
+
+	d.SetId(accountID)
+
+	return resourceCloudflareWorkersSubdomainRead(ctx, d, meta)
+}
+
+func resourceCloudflareWorkersSubdomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	res, err := rawConditionalGet(ctx, client, workersSubdomainEndpoint(d.Id()))
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading workers.dev subdomain: %w", err))
+	}
+
+	var subdomain workersSubdomain
+	if err := json.Unmarshal(res, &subdomain); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling workers.dev subdomain: %w", err))
+	}
+
+	if subdomain.Subdomain == "" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", d.Id())
+	d.Set("subdomain", subdomain.Subdomain)
+
+	return nil
+}
+
+func resourceCloudflareWorkersSubdomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// the API has no endpoint for unsetting the subdomain once registered;
+	// the resource is only removed from state
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("workers.dev subdomain %q removed from state but not deleted", d.Get("subdomain").(string)),
+			Detail:   "The Cloudflare API does not support removing the workers.dev subdomain of an account. The subdomain remains registered and can be re-imported.",
+		},
+	}
+}
+
+func resourceCloudflareWorkersSubdomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("account_id", d.Id())
+
+	resourceCloudflareWorkersSubdomainRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
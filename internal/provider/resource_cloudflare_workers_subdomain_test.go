@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareWorkersSubdomain(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_workers_subdomain.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkersSubdomainConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "subdomain", rnd),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkersSubdomainConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_workers_subdomain" "%[1]s" {
+		account_id = "%[2]s"
+		subdomain  = "%[1]s"
+	}`, name, accountID)
+}
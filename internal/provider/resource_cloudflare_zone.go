@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"golang.org/x/net/idna"
@@ -137,9 +139,44 @@ func resourceCloudflareZoneCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if vanityNS, ok := d.GetOk("vanity_name_servers"); ok {
+		if err := setZoneVanityNS(ctx, client, zone.ID, accountID, expandInterfaceToStringList(vanityNS.([]interface{}))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceCloudflareZoneRead(ctx, d, meta)
 }
 
+// setZoneVanityNS assigns vanity nameservers to the zone after verifying that
+// the account actually has custom nameservers configured, which the API
+// requires before a zone can reference them.
+func setZoneVanityNS(ctx context.Context, client *cloudflare.API, zoneID, accountID string, nameservers []string) error {
+	if len(nameservers) > 0 {
+		res, err := client.Raw(http.MethodGet, fmt.Sprintf("/accounts/%s/custom_ns", accountID), nil)
+		if err != nil {
+			return fmt.Errorf("error listing custom nameservers for account %q: %w", accountID, err)
+		}
+
+		var customNS []struct {
+			NSName string `json:"ns_name"`
+		}
+		if err := json.Unmarshal(res, &customNS); err != nil {
+			return fmt.Errorf("error decoding custom nameservers for account %q: %w", accountID, err)
+		}
+
+		if len(customNS) == 0 {
+			return fmt.Errorf("account %q has no custom nameservers configured; configure account-level custom nameservers before assigning vanity nameservers", accountID)
+		}
+	}
+
+	if _, err := client.ZoneSetVanityNS(ctx, zoneID, nameservers); err != nil {
+		return fmt.Errorf("error setting vanity nameservers on zone ID %q: %w", zoneID, err)
+	}
+
+	return nil
+}
+
 func resourceCloudflareZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Id()
@@ -207,6 +244,12 @@ func resourceCloudflareZoneUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if d.HasChange("vanity_name_servers") {
+		if err := setZoneVanityNS(ctx, client, zoneID, zone.Account.ID, expandInterfaceToStringList(d.Get("vanity_name_servers").([]interface{}))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// In the cases where the zone isn't completely setup yet, we need to
 	// check the `status` field and should it be pending, use the `LegacyID`
 	// from `zone.PlanPending` instead to account for paid plans.
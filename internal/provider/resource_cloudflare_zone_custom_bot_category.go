@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// zoneCustomBotCategory is the API representation of a custom bot category,
+// which allowlists traffic by TLS fingerprint for Bot Management.
+type zoneCustomBotCategory struct {
+	ID                  string   `json:"id,omitempty"`
+	Name                string   `json:"name"`
+	VerifiedBotOverride bool     `json:"verified_bot_override"`
+	JA3Fingerprints     []string `json:"ja3_fingerprints"`
+	JA4Fingerprints     []string `json:"ja4_fingerprints"`
+}
+
+func resourceCloudflareZoneCustomBotCategory() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZoneCustomBotCategorySchema(),
+		CreateContext: resourceCloudflareZoneCustomBotCategoryCreate,
+		ReadContext:   resourceCloudflareZoneCustomBotCategoryRead,
+		UpdateContext: resourceCloudflareZoneCustomBotCategoryUpdate,
+		DeleteContext: resourceCloudflareZoneCustomBotCategoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneCustomBotCategoryImport,
+		},
+		Description: "Provides a resource which manages a custom bot category of a zone, allowlisting traffic by JA3/JA4 TLS fingerprint for Bot Management. Requires Bot Management for Enterprise.",
+	}
+}
+
+func zoneCustomBotCategoryEndpoint(zoneID, categoryID string) string {
+	endpoint := fmt.Sprintf("/zones/%s/bot_management/custom_categories", zoneID)
+	if categoryID != "" {
+		endpoint = fmt.Sprintf("%s/%s", endpoint, categoryID)
+	}
+	return endpoint
+}
+
+func expandZoneCustomBotCategory(d *schema.ResourceData) zoneCustomBotCategory {
+	category := zoneCustomBotCategory{
+		Name:                d.Get("name").(string),
+		VerifiedBotOverride: d.Get("verified_bot_override").(bool),
+		JA3Fingerprints:     []string{},
+		JA4Fingerprints:     []string{},
+	}
+
+	for _, fingerprint := range d.Get("ja3_fingerprints").(*schema.Set).List() {
+		category.JA3Fingerprints = append(category.JA3Fingerprints, fingerprint.(string))
+	}
+	for _, fingerprint := range d.Get("ja4_fingerprints").(*schema.Set).List() {
+		category.JA4Fingerprints = append(category.JA4Fingerprints, fingerprint.(string))
+	}
+
+	return category
+}
+
+func resourceCloudflareZoneCustomBotCategoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := client.Raw(http.MethodPost, zoneCustomBotCategoryEndpoint(zoneID, ""), expandZoneCustomBotCategory(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating custom bot category: %w", err))
+	}
+
+	var category zoneCustomBotCategory
+	if err := json.Unmarshal(res, &category); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling custom bot category: %w", err))
+	}
+
+	d.SetId(category.ID)
+
+	return resourceCloudflareZoneCustomBotCategoryRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneCustomBotCategoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := client.Raw(http.MethodGet, zoneCustomBotCategoryEndpoint(zoneID, d.Id()), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading custom bot category %q: %w", d.Id(), err))
+	}
+
+	var category zoneCustomBotCategory
+	if err := json.Unmarshal(res, &category); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling custom bot category: %w", err))
+	}
+
+	d.Set("name", category.Name)
+	d.Set("verified_bot_override", category.VerifiedBotOverride)
+	d.Set("ja3_fingerprints", expandStringListToSet(category.JA3Fingerprints))
+	d.Set("ja4_fingerprints", expandStringListToSet(category.JA4Fingerprints))
+
+	return nil
+}
+
+func resourceCloudflareZoneCustomBotCategoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	_, err := client.Raw(http.MethodPut, zoneCustomBotCategoryEndpoint(zoneID, d.Id()), expandZoneCustomBotCategory(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating custom bot category %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareZoneCustomBotCategoryRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneCustomBotCategoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	_, err := client.Raw(http.MethodDelete, zoneCustomBotCategoryEndpoint(zoneID, d.Id()), nil)
+	if err != nil && !strings.Contains(err.Error(), "HTTP status 404") {
+		return diag.FromErr(fmt.Errorf("error deleting custom bot category %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneCustomBotCategoryImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf(`invalid id %q specified, should be in format "zoneID/categoryID"`, d.Id())
+	}
+
+	zoneID, categoryID := attributes[0], attributes[1]
+
+	d.Set("zone_id", zoneID)
+	d.SetId(categoryID)
+
+	resourceCloudflareZoneCustomBotCategoryRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareZoneCustomBotCategory(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zone_custom_bot_category.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneCustomBotCategoryConfig(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "verified_bot_override", "true"),
+					resource.TestCheckResourceAttr(name, "ja3_fingerprints.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneCustomBotCategoryConfig(name, zoneID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_zone_custom_bot_category" "%[1]s" {
+		zone_id               = "%[2]s"
+		name                  = "%[1]s"
+		verified_bot_override = true
+		ja3_fingerprints      = ["771c0264be3f5c9f8c6a4c69ab3c8a3f"]
+	}`, name, zoneID)
+}
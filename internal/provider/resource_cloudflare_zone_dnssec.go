@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -51,11 +53,56 @@ func resourceCloudflareZoneDNSSECCreate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	if err := updateZoneDNSSECFlags(client, zoneID, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(zoneID)
 
 	return resourceCloudflareZoneDNSSECRead(ctx, d, meta)
 }
 
+// zoneDNSSECFlags holds the DNSSEC settings the pinned client's
+// ZoneDNSSECUpdateOptions does not yet model.
+type zoneDNSSECFlags struct {
+	MultiSigner *bool `json:"dnssec_multi_signer,omitempty"`
+	Presigned   *bool `json:"dnssec_presigned,omitempty"`
+}
+
+func updateZoneDNSSECFlags(client *cloudflare.API, zoneID string, d *schema.ResourceData) error {
+	flags := zoneDNSSECFlags{
+		MultiSigner: cloudflare.BoolPtr(d.Get("dnssec_multi_signer").(bool)),
+		Presigned:   cloudflare.BoolPtr(d.Get("dnssec_presigned").(bool)),
+	}
+
+	if _, err := client.Raw(http.MethodPatch, fmt.Sprintf("/zones/%s/dnssec", zoneID), flags); err != nil {
+		return fmt.Errorf("error updating DNSSEC flags for zone %q: %w", zoneID, err)
+	}
+
+	return nil
+}
+
+func readZoneDNSSECFlags(client *cloudflare.API, zoneID string, d *schema.ResourceData) error {
+	res, err := client.Raw(http.MethodGet, fmt.Sprintf("/zones/%s/dnssec", zoneID), nil)
+	if err != nil {
+		return fmt.Errorf("error reading DNSSEC flags for zone %q: %w", zoneID, err)
+	}
+
+	var flags zoneDNSSECFlags
+	if err := json.Unmarshal(res, &flags); err != nil {
+		return fmt.Errorf("error decoding DNSSEC flags for zone %q: %w", zoneID, err)
+	}
+
+	if flags.MultiSigner != nil {
+		d.Set("dnssec_multi_signer", *flags.MultiSigner)
+	}
+	if flags.Presigned != nil {
+		d.Set("dnssec_presigned", *flags.Presigned)
+	}
+
+	return nil
+}
+
 func resourceCloudflareZoneDNSSECRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
@@ -89,12 +136,32 @@ func resourceCloudflareZoneDNSSECRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("public_key", dnssec.PublicKey)
 	d.Set("modified_on", dnssec.ModifiedOn.Format(time.RFC1123Z))
 
+	if dnssec.DS != "" {
+		d.Set("ds_record", []interface{}{map[string]interface{}{
+			"key_tag":     dnssec.KeyTag,
+			"algorithm":   dnssec.Algorithm,
+			"digest_type": dnssec.DigestType,
+			"digest":      dnssec.Digest,
+		}})
+	}
+
+	if err := readZoneDNSSECFlags(client, zoneID, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
-// Just returning remote state since changing the zone ID would force a new resource.
 func resourceCloudflareZoneDNSSECUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return resourceCloudflareZoneRead(ctx, d, meta)
+	client := meta.(*cloudflare.API)
+
+	zoneID := d.Get("zone_id").(string)
+
+	if err := updateZoneDNSSECFlags(client, zoneID, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareZoneDNSSECRead(ctx, d, meta)
 }
 
 func resourceCloudflareZoneDNSSECDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareZoneRecordsImport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCloudflareZoneRecordsImportCreate,
+		ReadContext:   resourceCloudflareZoneRecordsImportRead,
+		UpdateContext: resourceCloudflareZoneRecordsImportUpdate,
+		DeleteContext: resourceCloudflareZoneRecordsImportDelete,
+		Schema:        resourceCloudflareZoneRecordsImportSchema(),
+	}
+}
+
+// zoneFileRecord is a single record parsed out of a BIND-format zone file.
+type zoneFileRecord struct {
+	Name     string
+	Type     string
+	Content  string
+	TTL      int
+	Priority *uint16
+}
+
+// key returns a stable identifier used to match a parsed record against
+// records already present in the zone.
+func (r zoneFileRecord) key() string {
+	return fmt.Sprintf("%s/%s/%s", r.Type, r.Name, r.Content)
+}
+
+func resourceCloudflareZoneRecordsImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zoneID := d.Get("zone_id").(string)
+
+	if err := applyZoneFileRecords(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/records-import", zoneID)))
+
+	return resourceCloudflareZoneRecordsImportRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneRecordsImportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := applyZoneFileRecords(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareZoneRecordsImportRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneRecordsImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	managed := d.Get("record_ids").(map[string]interface{})
+	recordIDs := make(map[string]interface{})
+	for key, id := range managed {
+		_, err := client.DNSRecord(ctx, zoneID, id.(string))
+		if err != nil {
+			if strings.Contains(err.Error(), "HTTP status 404") {
+				tflog.Info(ctx, fmt.Sprintf("Imported DNS record %s no longer exists in zone %s", id, zoneID))
+				continue
+			}
+			return diag.FromErr(fmt.Errorf("error reading DNS record %q: %w", id.(string), err))
+		}
+		recordIDs[key] = id
+	}
+
+	d.Set("record_ids", recordIDs)
+	d.Set("total_records", len(recordIDs))
+
+	return nil
+}
+
+func resourceCloudflareZoneRecordsImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	if !d.Get("delete_records_on_destroy").(bool) {
+		tflog.Info(ctx, fmt.Sprintf("Leaving imported DNS records in place for zone %s", zoneID))
+		return nil
+	}
+
+	for key, id := range d.Get("record_ids").(map[string]interface{}) {
+		if err := client.DeleteDNSRecord(ctx, zoneID, id.(string)); err != nil {
+			if strings.Contains(err.Error(), "HTTP status 404") {
+				continue
+			}
+			return diag.FromErr(fmt.Errorf("error deleting imported DNS record %q (%s): %w", key, id.(string), err))
+		}
+	}
+
+	return nil
+}
+
+// applyZoneFileRecords parses the configured zone file and reconciles the
+// result against the records already present in the zone, creating missing
+// records and (optionally) overwriting records whose content drifted.
+func applyZoneFileRecords(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	zone, err := client.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return fmt.Errorf("error finding zone %q: %w", zoneID, err)
+	}
+
+	parsed, err := parseZoneFile(d.Get("zone_file").(string), zone.Name)
+	if err != nil {
+		return fmt.Errorf("error parsing zone file for zone %q: %w", zone.Name, err)
+	}
+
+	existing, err := client.DNSRecords(ctx, zoneID, cloudflare.DNSRecord{})
+	if err != nil {
+		return fmt.Errorf("error listing DNS records for zone %q: %w", zoneID, err)
+	}
+
+	existingByContent := make(map[string]cloudflare.DNSRecord)
+	existingByName := make(map[string]cloudflare.DNSRecord)
+	for _, r := range existing {
+		existingByContent[fmt.Sprintf("%s/%s/%s", r.Type, r.Name, r.Content)] = r
+		existingByName[fmt.Sprintf("%s/%s", r.Type, r.Name)] = r
+	}
+
+	overwrite := d.Get("overwrite_existing").(bool)
+	proxied := d.Get("proxied").(bool)
+	recordIDs := make(map[string]interface{})
+	for key, id := range d.Get("record_ids").(map[string]interface{}) {
+		recordIDs[key] = id
+	}
+
+	for _, record := range parsed {
+		if match, ok := existingByContent[record.key()]; ok {
+			tflog.Debug(ctx, fmt.Sprintf("DNS record %q already up to date", record.key()))
+			recordIDs[record.key()] = match.ID
+			continue
+		}
+
+		newRecord := cloudflare.DNSRecord{
+			Type:     record.Type,
+			Name:     record.Name,
+			Content:  record.Content,
+			TTL:      record.TTL,
+			Priority: record.Priority,
+			Proxied:  &proxied,
+		}
+
+		// CNAME, MX and friends are unique per name so drift shows up as a
+		// record with the same name but different content.
+		if match, ok := existingByName[fmt.Sprintf("%s/%s", record.Type, record.Name)]; ok && contains([]string{"CNAME", "SOA"}, record.Type) {
+			if !overwrite {
+				return fmt.Errorf("%s record %q already exists with content %q; set overwrite_existing to replace it", record.Type, record.Name, match.Content)
+			}
+			tflog.Info(ctx, fmt.Sprintf("Updating DNS record %s %s: %q => %q", record.Type, record.Name, match.Content, record.Content))
+			if err := client.UpdateDNSRecord(ctx, zoneID, match.ID, newRecord); err != nil {
+				return fmt.Errorf("error updating DNS record %q: %w", record.key(), err)
+			}
+			recordIDs[record.key()] = match.ID
+			continue
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Creating DNS record %s %s => %q", record.Type, record.Name, record.Content))
+		resp, err := client.CreateDNSRecord(ctx, zoneID, newRecord)
+		if err != nil {
+			return fmt.Errorf("error creating DNS record %q: %w", record.key(), err)
+		}
+		recordIDs[record.key()] = resp.Result.ID
+	}
+
+	d.Set("record_ids", recordIDs)
+
+	return nil
+}
+
+// parseZoneFile parses a BIND-format zone file into the records it declares.
+// It understands $ORIGIN and $TTL directives, "@" and relative owner names,
+// parenthesised continuations and the record types the DNS records API
+// accepts as simple content strings. SOA records are skipped since Cloudflare
+// manages the zone SOA itself.
+func parseZoneFile(contents, origin string) ([]zoneFileRecord, error) {
+	var records []zoneFileRecord
+
+	origin = strings.TrimSuffix(origin, ".")
+	defaultTTL := 1 // automatic
+	lastName := "@"
+
+	for lineNo, line := range splitZoneFileEntries(contents) {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "$ORIGIN":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("entry %d: $ORIGIN requires a value", lineNo+1)
+			}
+			origin = strings.TrimSuffix(fields[1], ".")
+			continue
+		case "$TTL":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("entry %d: $TTL requires a value", lineNo+1)
+			}
+			ttl, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("entry %d: invalid $TTL %q", lineNo+1, fields[1])
+			}
+			defaultTTL = ttl
+			continue
+		}
+
+		// owner names are only present when the entry does not start with
+		// whitespace; otherwise the previous owner name is reused.
+		name := lastName
+		if !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+			name = fields[0]
+			fields = fields[1:]
+		}
+		lastName = name
+
+		record := zoneFileRecord{Name: qualifyZoneFileName(name, origin), TTL: defaultTTL}
+
+		// optional TTL and class in either order before the type
+		for len(fields) > 0 {
+			if ttl, err := strconv.Atoi(fields[0]); err == nil {
+				record.TTL = ttl
+				fields = fields[1:]
+				continue
+			}
+			if strings.EqualFold(fields[0], "IN") {
+				fields = fields[1:]
+				continue
+			}
+			break
+		}
+
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("entry %d: missing record type or content: %q", lineNo+1, line)
+		}
+
+		record.Type = strings.ToUpper(fields[0])
+		rdata := fields[1:]
+
+		switch record.Type {
+		case "SOA":
+			continue
+		case "A", "AAAA", "NS", "PTR", "CNAME":
+			record.Content = strings.TrimSuffix(rdata[0], ".")
+			if record.Type == "CNAME" || record.Type == "NS" || record.Type == "PTR" {
+				record.Content = qualifyZoneFileName(rdata[0], origin)
+			}
+		case "MX":
+			if len(rdata) < 2 {
+				return nil, fmt.Errorf("entry %d: MX record requires a preference and exchange", lineNo+1)
+			}
+			preference, err := strconv.ParseUint(rdata[0], 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("entry %d: invalid MX preference %q", lineNo+1, rdata[0])
+			}
+			priority := uint16(preference)
+			record.Priority = &priority
+			record.Content = qualifyZoneFileName(rdata[1], origin)
+		case "TXT", "SPF":
+			record.Content = unquoteZoneFileText(rdata)
+		case "SRV", "CAA":
+			// passed through as-is; the API parses the content string
+			record.Content = strings.Join(rdata, " ")
+		default:
+			return nil, fmt.Errorf("entry %d: unsupported record type %q", lineNo+1, record.Type)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// splitZoneFileEntries splits zone file contents into one logical entry per
+// element, stripping comments and folding parenthesised continuations onto a
+// single line.
+func splitZoneFileEntries(contents string) []string {
+	var entries []string
+	var pending string
+	depth := 0
+
+	for _, line := range strings.Split(contents, "\n") {
+		if idx := strings.Index(line, ";"); idx > -1 {
+			line = line[:idx]
+		}
+
+		depth += strings.Count(line, "(") - strings.Count(line, ")")
+		line = strings.NewReplacer("(", " ", ")", " ").Replace(line)
+
+		if pending != "" {
+			pending += " " + strings.TrimSpace(line)
+		} else {
+			pending = strings.TrimRight(line, " \t")
+		}
+
+		if depth > 0 {
+			continue
+		}
+
+		if strings.TrimSpace(pending) != "" {
+			entries = append(entries, pending)
+		}
+		pending = ""
+	}
+
+	return entries
+}
+
+// qualifyZoneFileName resolves a possibly relative owner name against the
+// zone origin.
+func qualifyZoneFileName(name, origin string) string {
+	switch {
+	case name == "@" || name == "":
+		return origin
+	case strings.HasSuffix(name, "."):
+		// a trailing dot marks the name as fully qualified
+		return strings.TrimSuffix(name, ".")
+	default:
+		return name + "." + origin
+	}
+}
+
+// unquoteZoneFileText joins the quoted character strings of a TXT record.
+func unquoteZoneFileText(fields []string) string {
+	var parts []string
+	for _, f := range fields {
+		parts = append(parts, strings.Trim(f, `"`))
+	}
+	return strings.Join(parts, " ")
+}
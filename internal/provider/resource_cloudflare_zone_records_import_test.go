@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	zoneFile := `
+$ORIGIN example.com.
+$TTL 300
+; zone comment
+@       IN SOA ns1.example.com. hostmaster.example.com. (
+          2022010101 ; serial
+          7200 3600 1209600 3600 )
+@       IN A     192.0.2.1
+www     600 IN A 192.0.2.2
+        IN A     192.0.2.3
+mail    IN CNAME www
+@       IN MX 10 mail.example.com.
+txt     IN TXT   "hello" "world"
+`
+
+	records, err := parseZoneFile(zoneFile, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error parsing zone file: %s", err)
+	}
+
+	expected := []zoneFileRecord{
+		{Name: "example.com", Type: "A", Content: "192.0.2.1", TTL: 300},
+		{Name: "www.example.com", Type: "A", Content: "192.0.2.2", TTL: 600},
+		{Name: "www.example.com", Type: "A", Content: "192.0.2.3", TTL: 300},
+		{Name: "mail.example.com", Type: "CNAME", Content: "www.example.com", TTL: 300},
+		{Name: "example.com", Type: "MX", Content: "mail.example.com", TTL: 300},
+		{Name: "txt.example.com", Type: "TXT", Content: "hello world", TTL: 300},
+	}
+
+	if len(records) != len(expected) {
+		t.Fatalf("expected %d records, got %d: %+v", len(expected), len(records), records)
+	}
+
+	for i, want := range expected {
+		got := records[i]
+		if got.Name != want.Name || got.Type != want.Type || got.Content != want.Content || got.TTL != want.TTL {
+			t.Errorf("record %d: expected %+v, got %+v", i, want, got)
+		}
+	}
+
+	mx := records[4]
+	if mx.Priority == nil || *mx.Priority != 10 {
+		t.Errorf("expected MX priority 10, got %+v", mx.Priority)
+	}
+}
+
+func TestParseZoneFileUnsupportedType(t *testing.T) {
+	if _, err := parseZoneFile("@ IN WKS 192.0.2.1 6", "example.com"); err == nil {
+		t.Fatal("expected an error for an unsupported record type")
+	}
+}
@@ -163,13 +163,24 @@ func resourceCloudflareZoneSettingsOverrideRead(ctx context.Context, d *schema.R
 
 func flattenZoneSettings(ctx context.Context, d *schema.ResourceData, settings []cloudflare.ZoneSetting, flattenAll bool) []map[string]interface{} {
 	cfg := map[string]interface{}{}
+	additional := map[string]interface{}{}
+	configuredAdditional := d.Get("settings.0.additional_settings").(map[string]interface{})
 	for _, s := range settings {
 		if s.ID == "0rtt" { // NOTE: 0rtt is an invalid attribute in HCLs grammar.  Remap to `zero_rtt`
 			s.ID = "zero_rtt"
 		}
 
 		if !settingInSchema(s.ID) {
-			log.Printf("[WARN] Value not in schema returned from API zone settings (is it new?) - %q : %#v", s.ID, s.Value)
+			// settings without a named attribute can still be managed through
+			// the additional_settings map as long as they are string-valued
+			strValue, isString := s.Value.(string)
+			if !isString {
+				log.Printf("[WARN] Value not in schema returned from API zone settings (is it new?) - %q : %#v", s.ID, s.Value)
+				continue
+			}
+			if _, ok := configuredAdditional[s.ID]; ok || flattenAll {
+				additional[s.ID] = strValue
+			}
 			continue
 		}
 		if _, ok := d.GetOkExists(fmt.Sprintf("settings.0.%s", s.ID)); !ok && !flattenAll {
@@ -192,6 +203,10 @@ func flattenZoneSettings(ctx context.Context, d *schema.ResourceData, settings [
 		}
 	}
 
+	if len(additional) > 0 {
+		cfg["additional_settings"] = additional
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Flattened Cloudflare Zone Settings: %#v", cfg))
 
 	return []map[string]interface{}{cfg}
@@ -263,7 +278,18 @@ func resourceCloudflareZoneSettingsOverrideUpdate(ctx context.Context, d *schema
 
 	if cfg, ok := d.GetOkExists("settings"); ok && cfg != nil && len(cfg.([]interface{})) > 0 {
 		readOnlySettings := expandInterfaceToStringList(d.Get("readonly_settings"))
-		zoneSettings, err := expandOverriddenZoneSettings(d, "settings", readOnlySettings)
+
+		// the keys of additional_settings are only known at apply time; they
+		// are validated against the settings the API reports for the zone
+		var knownSettingIDs []string
+		if len(d.Get("settings.0.additional_settings").(map[string]interface{})) > 0 {
+			var err error
+			if knownSettingIDs, err = fetchKnownZoneSettingIDs(ctx, client, d.Id()); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		zoneSettings, err := expandOverriddenZoneSettings(d, "settings", readOnlySettings, knownSettingIDs)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -291,12 +317,63 @@ func resourceCloudflareZoneSettingsOverrideUpdate(ctx context.Context, d *schema
 	return resourceCloudflareZoneSettingsOverrideRead(ctx, d, meta)
 }
 
-func expandOverriddenZoneSettings(d *schema.ResourceData, settingsKey string, readOnlySettings []string) ([]cloudflare.ZoneSetting, error) {
+// fetchKnownZoneSettingIDs returns the setting names the API reports for the
+// zone, so additional_settings keys can be validated without a hardcoded list
+// and settings released after this provider version remain manageable.
+func fetchKnownZoneSettingIDs(ctx context.Context, client *cloudflare.API, zoneID string) ([]string, error) {
+	zoneSettings, err := client.ZoneSettings(ctx, zoneID)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Error reading known settings for zone %q", zoneID))
+	}
+
+	ids := make([]string, 0, len(zoneSettings.Result))
+	for _, s := range zoneSettings.Result {
+		ids = append(ids, s.ID)
+	}
+	return ids, nil
+}
+
+// expandAdditionalZoneSettings expands the additional_settings map into
+// individual zone settings. The keys are validated against knownSettingIDs
+// when a list is supplied; a nil list skips the check, which is used when
+// reverting to values that originally came from the API.
+func expandAdditionalZoneSettings(cfg map[string]interface{}, readOnlySettings, knownSettingIDs []string) ([]cloudflare.ZoneSetting, error) {
+	zoneSettings := make([]cloudflare.ZoneSetting, 0, len(cfg))
+
+	for k, v := range cfg {
+		if settingInSchema(k) {
+			return nil, fmt.Errorf("invalid additional zone setting %q found - use the attribute of the same name instead", k)
+		}
+		if contains(readOnlySettings, k) {
+			return nil, fmt.Errorf("invalid zone setting %q (value: %v) found - cannot be set as it is read only", k, v)
+		}
+		if knownSettingIDs != nil && !contains(knownSettingIDs, k) {
+			return nil, fmt.Errorf("invalid additional zone setting %q found - the API does not report a setting of that name for this zone", k)
+		}
+		zoneSettings = append(zoneSettings, cloudflare.ZoneSetting{
+			ID:    k,
+			Value: v,
+		})
+	}
+	return zoneSettings, nil
+}
+
+func expandOverriddenZoneSettings(d *schema.ResourceData, settingsKey string, readOnlySettings []string, knownSettingIDs []string) ([]cloudflare.ZoneSetting, error) {
 	zoneSettings := make([]cloudflare.ZoneSetting, 0)
 
 	keyFormat := fmt.Sprintf("%s.0.%%s", settingsKey)
 
 	for k := range resourceCloudflareZoneSettingsSchema {
+		if k == "additional_settings" {
+			if additionalCfg, ok := d.GetOkExists(fmt.Sprintf(keyFormat, k)); ok && d.HasChange(fmt.Sprintf(keyFormat, k)) {
+				additional, err := expandAdditionalZoneSettings(additionalCfg.(map[string]interface{}), readOnlySettings, knownSettingIDs)
+				if err != nil {
+					return zoneSettings, err
+				}
+				zoneSettings = append(zoneSettings, additional...)
+			}
+			continue
+		}
 		// we only update if the user set the value non-empty before, and its different from the read value
 		// note that if user removes an attribute, we don't do anything
 		if settingValue, ok := d.GetOkExists(fmt.Sprintf(keyFormat, k)); ok && d.HasChange(fmt.Sprintf(keyFormat, k)) {
@@ -406,6 +483,25 @@ func expandRevertibleZoneSettings(d *schema.ResourceData, readOnlySettings []str
 		initialVal := d.Get(initialKey)
 		currentKey := fmt.Sprintf("settings.0.%s", k)
 
+		// only the additional settings that were overridden are reverted to
+		// their initial values; the keys came from the API, so they are not
+		// re-validated
+		if k == "additional_settings" {
+			initial := initialVal.(map[string]interface{})
+			toRevert := map[string]interface{}{}
+			for name, currentValue := range d.Get(currentKey).(map[string]interface{}) {
+				if initialValue, ok := initial[name]; ok && initialValue != currentValue {
+					toRevert[name] = initialValue
+				}
+			}
+			additional, err := expandAdditionalZoneSettings(toRevert, readOnlySettings, nil)
+			if err != nil {
+				return zoneSettings, err
+			}
+			zoneSettings = append(zoneSettings, additional...)
+			continue
+		}
+
 		if k == "zero_rtt" {
 			k = "0rtt"
 		}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareZoneSnippetsRules() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZoneSnippetsRulesSchema(),
+		CreateContext: resourceCloudflareZoneSnippetsRulesCreate,
+		ReadContext:   resourceCloudflareZoneSnippetsRulesRead,
+		UpdateContext: resourceCloudflareZoneSnippetsRulesUpdate,
+		DeleteContext: resourceCloudflareZoneSnippetsRulesDelete,
+		CustomizeDiff: resourceCloudflareZoneSnippetsRulesDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneSnippetsRulesImport,
+		},
+	}
+}
+
+// zoneSnippetsRule represents a single snippet rule, which the pinned client
+// does not yet model.
+type zoneSnippetsRule struct {
+	Expression  string `json:"expression"`
+	SnippetName string `json:"snippet_name"`
+	Description string `json:"description,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+}
+
+func zoneSnippetsRulesEndpoint(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/snippets/snippet_rules", zoneID)
+}
+
+// resourceCloudflareZoneSnippetsRulesDiff rejects rule lists the API would
+// refuse anyway: a snippet can only be attached to one rule per zone, and two
+// rules with the same expression would shadow each other.
+func resourceCloudflareZoneSnippetsRulesDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	seenExpressions := make(map[string]int)
+	seenSnippets := make(map[string]int)
+
+	for i, rawRule := range d.Get("rules").([]interface{}) {
+		rule := rawRule.(map[string]interface{})
+
+		expression := rule["expression"].(string)
+		if previous, ok := seenExpressions[expression]; ok {
+			return fmt.Errorf("rules %d and %d have conflicting expressions (%q); each rule must match a distinct set of requests", previous, i, expression)
+		}
+		seenExpressions[expression] = i
+
+		snippetName := rule["snippet_name"].(string)
+		if previous, ok := seenSnippets[snippetName]; ok {
+			return fmt.Errorf("rules %d and %d both reference snippet %q; a snippet can only be attached to one rule per zone", previous, i, snippetName)
+		}
+		seenSnippets[snippetName] = i
+	}
+
+	return nil
+}
+
+func expandZoneSnippetsRules(d *schema.ResourceData) []zoneSnippetsRule {
+	rules := []zoneSnippetsRule{}
+	for _, rawRule := range d.Get("rules").([]interface{}) {
+		rule := rawRule.(map[string]interface{})
+		enabled := rule["enabled"].(bool)
+		rules = append(rules, zoneSnippetsRule{
+			Expression:  rule["expression"].(string),
+			SnippetName: rule["snippet_name"].(string),
+			Description: rule["description"].(string),
+			Enabled:     &enabled,
+		})
+	}
+	return rules
+}
+
+func updateZoneSnippetsRules(client *cloudflare.API, zoneID string, rules []zoneSnippetsRule) error {
+	payload := struct {
+		Rules []zoneSnippetsRule `json:"rules"`
+	}{Rules: rules}
+
+	_, err := client.Raw(http.MethodPut, zoneSnippetsRulesEndpoint(zoneID), payload)
+	return err
+}
+
+func resourceCloudflareZoneSnippetsRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	if err := updateZoneSnippetsRules(client, zoneID, expandZoneSnippetsRules(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating zone snippets rules for zone %q: %w", zoneID, err))
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareZoneSnippetsRulesRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneSnippetsRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := client.Raw(http.MethodGet, zoneSnippetsRulesEndpoint(zoneID), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			tflog.Info(ctx, fmt.Sprintf("Zone snippets rules for zone %s no longer exist", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading zone snippets rules for zone %q: %w", zoneID, err))
+	}
+
+	var result struct {
+		Rules []zoneSnippetsRule `json:"rules"`
+	}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal zone snippets rules: %w", err))
+	}
+
+	rules := make([]interface{}, 0, len(result.Rules))
+	for _, rule := range result.Rules {
+		flattened := map[string]interface{}{
+			"expression":   rule.Expression,
+			"snippet_name": rule.SnippetName,
+			"description":  rule.Description,
+		}
+		if rule.Enabled != nil {
+			flattened["enabled"] = *rule.Enabled
+		}
+		rules = append(rules, flattened)
+	}
+
+	if err := d.Set("rules", rules); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set rules: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneSnippetsRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	if err := updateZoneSnippetsRules(client, zoneID, expandZoneSnippetsRules(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating zone snippets rules for zone %q: %w", zoneID, err))
+	}
+
+	return resourceCloudflareZoneSnippetsRulesRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneSnippetsRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	if err := updateZoneSnippetsRules(client, zoneID, []zoneSnippetsRule{}); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting zone snippets rules for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneSnippetsRulesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zoneID := d.Id()
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareZoneSnippetsRulesRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
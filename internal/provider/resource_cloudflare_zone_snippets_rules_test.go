@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareZoneSnippetsRules(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zone_snippets_rules.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneSnippetsRulesConfig(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "rules.#", "1"),
+					resource.TestCheckResourceAttr(name, "rules.0.snippet_name", rnd),
+					resource.TestCheckResourceAttr(name, "rules.0.expression", `http.request.uri.path contains "/snippets"`),
+					resource.TestCheckResourceAttr(name, "rules.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneSnippetsRulesConfig(name, zoneID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_zone_snippets_rules" "%[1]s" {
+		zone_id = "%[2]s"
+
+		rules {
+			snippet_name = "%[1]s"
+			expression   = "http.request.uri.path contains \"/snippets\""
+			enabled      = true
+		}
+	}`, name, zoneID)
+}
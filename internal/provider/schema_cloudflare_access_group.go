@@ -37,6 +37,12 @@ func resourceCloudflareAccessGroupSchema() map[string]*schema.Schema {
 			Required: true,
 			Elem:     AccessGroupOptionSchemaElement,
 		},
+		"validate_login_methods": {
+			Description: "Whether to validate `login_method` values against the identity providers configured on the account or zone before applying.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
 	}
 }
 
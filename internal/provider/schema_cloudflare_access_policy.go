@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -82,6 +83,19 @@ func resourceCloudflareAccessPolicySchema() map[string]*schema.Schema {
 			Optional: true,
 			Elem:     AccessPolicyApprovalGroupElement,
 		},
+		"session_duration": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+				v := val.(string)
+				_, err := time.ParseDuration(v)
+				if err != nil {
+					errs = append(errs, fmt.Errorf(`%q only supports "ns", "us" (or "µs"), "ms", "s", "m", or "h" as valid units.`, key))
+				}
+				return
+			},
+			Description: "How long a user's session is valid for after satisfying this policy, overriding the application's session duration. Combined with `approval_required`, this codifies time-bounded break-glass access.",
+		},
 	}
 }
 
@@ -22,5 +22,17 @@ func resourceCloudflareAccountMemberSchema() map[string]*schema.Schema {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Description: "List of account role IDs that you want to assign to a member.",
 		},
+
+		"resend_invite": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Arbitrary value that, when changed, re-sends the invitation email while the membership is still `pending`. Each resend restarts the expiry window of the invitation.",
+		},
+
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the membership: `pending` until the invitation is accepted, then `accepted`.",
+		},
 	}
 }
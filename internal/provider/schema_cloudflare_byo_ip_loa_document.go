@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareBYOIPLOADocumentSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"filename": {
+			Description: "The filename the LOA document is uploaded as.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     "loa.pdf",
+		},
+		"content_base64": {
+			Description: "The base64 encoded contents of the LOA document (PDF).",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Sensitive:   true,
+		},
+		"created": {
+			Description: "RFC3339 timestamp of when the LOA document was uploaded.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"size_bytes": {
+			Description: "The size of the uploaded LOA document in bytes.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	}
+}
@@ -70,5 +70,10 @@ func resourceCloudflareCertificatePackSchema() map[string]*schema.Schema {
 			Optional: true,
 			Default:  false,
 		},
+		"revalidate": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Arbitrary value that, when changed, restarts domain control validation of an `advanced` certificate pack, e.g. after fixing the DCV records. Honours `wait_for_active_status`.",
+		},
 	}
 }
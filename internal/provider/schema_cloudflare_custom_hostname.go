@@ -27,6 +27,26 @@ func resourceCloudflareCustomHostnameSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"custom_metadata": {
+			Description: "Custom metadata key/value pairs associated with the hostname, acted on by Workers or other custom logic. Requires the custom metadata feature to be enabled for the zone.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"wait_for_ssl_pending_validation": {
+			Description: "Whether to wait for a custom hostname SSL sub-object to reach status `pending_validation` during creation.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"wait_for_active": {
+			Description: "Whether to wait for both the hostname and its SSL sub-object to reach status `active` during creation and updates.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
 		"ssl": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -137,5 +157,24 @@ func resourceCloudflareCustomHostnameSchema() map[string]*schema.Schema {
 				Computed: true,
 			},
 		},
+		"dcv_delegation_records": {
+			Description: "The CNAME records to publish so certificate validation for the hostname is delegated to Cloudflare. Empty when DCV delegation is not available for the zone.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cname": {
+						Description: "The name of the CNAME record to create.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"cname_target": {
+						Description: "The target of the CNAME record.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
 	}
 }
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareD1DatabaseSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the D1 database.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"primary_location_hint": {
+			Description:  "A hint for the region the primary copy of the database should be placed in. Valid values: `wnam`, `enam`, `weur`, `eeur`, `apac`, `oc`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"wnam", "enam", "weur", "eeur", "apac", "oc"}, false),
+		},
+		"read_replication_mode": {
+			Description:  "The read replication mode of the database: `auto` creates read replicas near the database's traffic, `disabled` serves all reads from the primary. Default: `disabled`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "disabled",
+			ValidateFunc: validation.StringInSlice([]string{"auto", "disabled"}, false),
+		},
+		"version": {
+			Description: "The backend version of the database.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
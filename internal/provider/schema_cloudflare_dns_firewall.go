@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareDNSFirewallSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the DNS Firewall cluster.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"upstream_ips": {
+			Description: "The upstream nameserver IPs the cluster forwards queries to.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsIPAddress,
+			},
+		},
+		"minimum_cache_ttl": {
+			Description:  "The minimum number of seconds to cache responses from the upstream nameservers, regardless of the response TTL.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      60,
+			ValidateFunc: validation.IntBetween(30, 36000),
+		},
+		"maximum_cache_ttl": {
+			Description:  "The maximum number of seconds to cache responses from the upstream nameservers, regardless of the response TTL.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      900,
+			ValidateFunc: validation.IntBetween(30, 36000),
+		},
+		"deprecate_any_requests": {
+			Description: "Whether to refuse queries of type ANY with a NOTIMP response.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"ecs_fallback": {
+			Description: "Whether to forward queries to the upstream nameservers without the EDNS Client Subnet option when the initial response is REFUSED.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"ratelimit": {
+			Description:  "The maximum number of queries per second the cluster answers. Unset means unlimited.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(100, 1000000000),
+		},
+		"dns_firewall_ips": {
+			Description: "The DNS Firewall IPs assigned to the cluster, to be configured as the zone's nameservers at the registrar.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"modified_on": {
+			Description: "RFC3339 timestamp of when the cluster was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
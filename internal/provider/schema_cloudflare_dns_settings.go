@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareDNSSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+
+		"foundation_dns": {
+			Description: "Whether to enable Foundation DNS advanced nameservers for the zone.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+
+		"multi_provider": {
+			Description: "Whether to enable multi-provider DNS, which causes Cloudflare to activate the zone even when non-Cloudflare NS records exist, and to respect NS records at the zone apex during outbound zone transfers.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+
+		"secondary_overrides": {
+			Description: "Whether to allow a secondary zone to use its own DNS settings in place of the transferred ones.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+
+		"ns_ttl": {
+			Description:  "The time to live (TTL) of the zone's nameserver (NS) records.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(30, 86400),
+		},
+
+		"zone_mode": {
+			Description:  "The mode of the zone.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"standard", "cdn_only", "dns_only"}, false),
+		},
+
+		"nameservers": {
+			Description: "Settings determining the nameservers through which the zone should be available.",
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Description:  "The type of nameservers to use for the zone.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"cloudflare.standard", "custom.account", "custom.tenant"}, false),
+					},
+				},
+			},
+		},
+
+		"soa": {
+			Description: "Components of the zone's SOA record.",
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"expire": {
+						Description:  "Time in seconds of being unable to query the primary server after which secondary servers should stop serving the zone.",
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(86400, 2419200),
+					},
+					"min_ttl": {
+						Description:  "The time to live (TTL) for negative caching of records within the zone.",
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(60, 86400),
+					},
+					"mname": {
+						Description: "The primary nameserver, which may be used for outbound zone transfers.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"refresh": {
+						Description:  "Time in seconds after which secondary servers should re-check the SOA record to see if the zone has been updated.",
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(600, 86400),
+					},
+					"retry": {
+						Description:  "Time in seconds after which secondary servers should retry failed zone transfers.",
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(600, 86400),
+					},
+					"rname": {
+						Description: "The email address of the zone administrator, with the first label representing the local part of the email address.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"ttl": {
+						Description:  "The time to live (TTL) of the SOA record itself.",
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(300, 86400),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareDNSZoneExclusiveRecordsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+
+		"record": {
+			Description: "The complete set of records the zone should hold. Records in the zone that are not declared here are deleted unless an `ignore` filter covers them.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The full name of the record, e.g. `www.example.com`.",
+						Type:        schema.TypeString,
+						Required:    true,
+						StateFunc: func(i interface{}) string {
+							return strings.ToLower(i.(string))
+						},
+					},
+					"type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS", "SVCB"}, false),
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"ttl": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Default:  1,
+					},
+					"priority": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Default:  0,
+					},
+					"proxied": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"ignore": {
+			Description: "Filters for records that should be left alone even though they are not declared in `record`.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "A record name to leave alone. A leading `*.` acts as a wildcard, e.g. `*.acme.example.com`.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"type": {
+						Description: "A record type to leave alone, e.g. `TXT`.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
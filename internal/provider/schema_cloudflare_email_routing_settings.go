@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareEmailRoutingSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+
+		"enabled": {
+			Description: "Whether email routing is enabled for the zone.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+
+		"skip_wizard": {
+			Description: "Whether to skip the configuration wizard in the dashboard.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+
+		"subaddressing": {
+			Description: "Whether to enable subaddressing (plus-addressing), routing mail for `user+tag@example.com` using the rules configured for `user@example.com`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+
+		"catch_all": {
+			Description: "Wildcard catch-all behavior for mail no other routing rule matches.",
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Description: "Whether the catch-all rule is active.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+					"action": {
+						Description:  "What to do with mail the catch-all rule matches.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"forward", "worker", "drop"}, false),
+					},
+					"destinations": {
+						Description: "Destination addresses (for `forward`) or Worker script names (for `worker`) the matched mail is sent to.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"name": {
+			Description: "The email routing rule set name for the zone.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+
+		"status": {
+			Description: "The state of email routing for the zone.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+
+		"tag": {
+			Description: "The email routing rule set identifier.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -153,6 +153,27 @@ func resourceCloudflareHealthcheckSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"suspension_window": {
+			Description: "Windows of planned origin maintenance during which health check notifications are suppressed. Probes keep running, but status changes inside a window do not notify.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"start_time": {
+						Description:  "When the suppression window opens, in RFC 3339 format.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+					"end_time": {
+						Description:  "When the suppression window closes, in RFC 3339 format.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+				},
+			},
+		},
 		"notification_suspended": {
 			Description: "Whether the notifications are suspended or not. Useful for maintenance periods.",
 			Type:        schema.TypeBool,
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareIntelIndicatorFeedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the indicator feed.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"description": {
+			Description: "A description of the indicator feed.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"snapshot": {
+			Description: "The contents of a STIX 2 file to publish as the current snapshot of the feed. A new snapshot is uploaded whenever the contents change.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"shared_accounts": {
+			Description: "Account tags of other accounts the feed is shared with, allowing them to use the feed in their Gateway policies.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"created_on": {
+			Description: "Timestamp of when the feed was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"modified_on": {
+			Description: "Timestamp of when the feed was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareKeylessSSLSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The name of the Keyless SSL configuration. Generated from the host if not set.",
+		},
+		"host": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The hostname the key server listens on.",
+		},
+		"port": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     24008,
+			Description: "The port the key server listens on.",
+		},
+		"certificate": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The public certificate whose private key stays on the key server, in PEM format.",
+		},
+		"bundle_method": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "ubiquitous",
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"ubiquitous", "optimal", "force"}, false),
+			Description:  "The method used to bundle the certificate chain.",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether the Keyless SSL configuration is active.",
+		},
+		"tunnel": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"vnet_id": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The virtual network the tunnel runs in.",
+					},
+					"private_ip": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The private IP of the key server inside the virtual network.",
+					},
+				},
+			},
+			Description: "Reaches the key server through a Cloudflare Tunnel in a virtual network instead of over the public internet.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The connectivity status of the key server.",
+		},
+	}
+}
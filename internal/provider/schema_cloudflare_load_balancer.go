@@ -71,7 +71,7 @@ func resourceCloudflareLoadBalancerSchema() map[string]*schema.Schema {
 		"steering_policy": {
 			Type:         schema.TypeString,
 			Optional:     true,
-			ValidateFunc: validation.StringInSlice([]string{"off", "geo", "dynamic_latency", "random", "proximity", ""}, false),
+			ValidateFunc: validation.StringInSlice([]string{"off", "geo", "dynamic_latency", "random", "proximity", "least_outstanding_requests", "least_connections", ""}, false),
 			Computed:     true,
 		},
 
@@ -90,6 +90,27 @@ func resourceCloudflareLoadBalancerSchema() map[string]*schema.Schema {
 			},
 		},
 
+		"adaptive_routing": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem:     adaptiveRoutingElem,
+		},
+
+		"location_strategy": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem:     locationStrategyElem,
+		},
+
+		"random_steering": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem:     randomSteeringElem,
+		},
+
 		"rules": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -111,6 +132,13 @@ func resourceCloudflareLoadBalancerSchema() map[string]*schema.Schema {
 			Elem:     regionPoolElem,
 		},
 
+		"country_pools": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem:     countryPoolElem,
+		},
+
 		"created_on": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -38,6 +38,22 @@ func resourceCloudflareLoadBalancerMonitorSchema() map[string]*schema.Schema {
 			ValidateFunc: validation.IntBetween(1, 5),
 		},
 
+		"consecutive_up": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "To be marked healthy the monitored origin must pass this healthcheck N consecutive times.",
+		},
+
+		"consecutive_down": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "To be marked unhealthy the monitored origin must fail this healthcheck N consecutive times.",
+		},
+
 		"timeout": {
 			Type:         schema.TypeInt,
 			Optional:     true,
@@ -52,6 +68,11 @@ func resourceCloudflareLoadBalancerMonitorSchema() map[string]*schema.Schema {
 			ValidateFunc: validation.StringInSlice([]string{"http", "https", "tcp", "udp_icmp", "icmp_ping", "smtp"}, false),
 		},
 
+		"probe_zone": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
 		"created_on": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -112,10 +133,5 @@ func resourceCloudflareLoadBalancerMonitorSchema() map[string]*schema.Schema {
 			Optional: true,
 			Computed: true,
 		},
-
-		"probe_zone": {
-			Type:     schema.TypeString,
-			Optional: true,
-		},
 	}
 }
@@ -111,6 +111,20 @@ var originsElem = &schema.Resource{
 			},
 		},
 
+		"port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 65535),
+			Description:  "The port to override the default port of the load balancer for this origin. `0` uses the default.",
+		},
+
+		"virtual_network_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The ID of the virtual network (`cloudflare_tunnel_virtual_network`) the origin address is reachable through, for private origins behind tunnels.",
+		},
+
 		"weight": {
 			Type:         schema.TypeFloat,
 			Optional:     true,
@@ -185,7 +199,7 @@ var originSteeringElem = &schema.Resource{
 			Type:         schema.TypeString,
 			Default:      "random",
 			Optional:     true,
-			ValidateFunc: validation.StringInSlice([]string{"", "hash", "random"}, false),
+			ValidateFunc: validation.StringInSlice([]string{"", "hash", "random", "least_outstanding_requests", "least_connections"}, false),
 		},
 	},
 }
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareLogpushEdgeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"fields": {
+			Description: "Comma-separated list of request fields to include in the log lines, e.g. `ClientIP,ClientRequestHost,EdgeResponseStatus`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"sample": {
+			Description:  "The sampling rate of the logs, expressed as 1 in `sample` requests. `1` streams all requests.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"filter": {
+			Description: "Filter to restrict the streamed requests, expressed in the Logpush filter JSON syntax.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"session_id": {
+			Description: "The identifier of the Instant Logs session.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"destination_conf": {
+			Description: "The websocket URL to connect to for streaming the live logs of the session.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,187 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceCloudflareMagicWANSiteSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the site.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"description": {
+			Description: "Description of the site.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"connector_id": {
+			Description: "Magic WAN Connector identifier associated with the site.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"secondary_connector_id": {
+			Description: "Magic WAN Connector identifier used as the secondary connector in high availability mode.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"ha_mode": {
+			Description: "Whether the site runs in high availability mode.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    true,
+		},
+		"location": {
+			Description: "Physical location of the site.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"lat": {
+						Description: "Latitude of the site.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"lon": {
+						Description: "Longitude of the site.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCloudflareMagicWANLANSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"site_id": {
+			Description: "Identifier of the Magic WAN site the LAN belongs to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the LAN.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"physport": {
+			Description: "Physical port number on the connector the LAN is attached to.",
+			Type:        schema.TypeInt,
+			Required:    true,
+		},
+		"vlan_tag": {
+			Description: "VLAN tag used on the port.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+		},
+		"ha_link": {
+			Description: "Whether this LAN is the high availability link between connectors.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    true,
+		},
+		"static_addressing": {
+			Description: "Static addressing configuration for the LAN. Required unless the LAN is the high availability link.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"address": {
+						Description: "Address of the LAN interface in CIDR notation.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"virtual_address": {
+						Description: "Virtual address shared between connectors in high availability mode, in CIDR notation.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"secondary_address": {
+						Description: "Address of the secondary connector's LAN interface in CIDR notation.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCloudflareMagicWANWANSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"site_id": {
+			Description: "Identifier of the Magic WAN site the WAN belongs to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the WAN.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"physport": {
+			Description: "Physical port number on the connector the WAN is attached to.",
+			Type:        schema.TypeInt,
+			Required:    true,
+		},
+		"vlan_tag": {
+			Description: "VLAN tag used on the port.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+		},
+		"priority": {
+			Description: "Priority of the WAN for failover; lower values are preferred.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+		},
+		"static_addressing": {
+			Description: "Static addressing configuration for the WAN. When omitted, the WAN uses DHCP.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"address": {
+						Description: "Address of the WAN interface in CIDR notation.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"gateway_address": {
+						Description: "Address of the default gateway.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"secondary_address": {
+						Description: "Address of the secondary connector's WAN interface in CIDR notation.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
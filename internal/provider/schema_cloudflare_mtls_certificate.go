@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareMTLSCertificateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Optional unique name for the certificate.",
+		},
+		"ca": {
+			Type:        schema.TypeBool,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Whether the certificate is a CA bundle used to verify client certificates, rather than a leaf certificate.",
+		},
+		"certificates": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The certificate chain to upload, in PEM format.",
+		},
+		"private_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Sensitive:   true,
+			Description: "The private key of a leaf certificate, in PEM format. Not set for CA bundles.",
+		},
+		"issuer": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The distinguished name of the certificate issuer.",
+		},
+		"serial_number": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The serial number of the certificate.",
+		},
+		"signature": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The signature algorithm of the certificate.",
+		},
+		"uploaded_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the certificate was uploaded, in RFC 3339 format.",
+		},
+		"expires_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the certificate expires, in RFC 3339 format.",
+		},
+	}
+}
@@ -16,6 +16,12 @@ func resourceCloudflareOriginCACertificateSchema() map[string]*schema.Schema {
 			Optional:     true,
 			ValidateFunc: validateCSR,
 		},
+		"early_renewal_days": {
+			Description:  "Number of days prior to the expiry of the certificate at which refresh marks it for re-issuance.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
 		"expires_on": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflarePagesDomainSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"project_name": {
+			Description: "The name of the Pages project to attach the domain to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"domain": {
+			Description: "The custom domain to attach to the project.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"wait_for_active": {
+			Description: "Whether to wait for the domain to become active before completing the apply.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"status": {
+			Description: "The status of the domain.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"verification_status": {
+			Description: "The ownership verification status of the domain.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"validation_status": {
+			Description: "The certificate validation status of the domain.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"validation_method": {
+			Description: "The certificate validation method used for the domain.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"zone_tag": {
+			Description: "The identifier of the zone the domain belongs to, if it is on the same account.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var pagesProjectDeploymentConfigResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"environment_variables": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Plain text environment variables available to the environment.",
+		},
+		"secrets": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Sensitive:   true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Encrypted environment variables available to the environment.",
+		},
+		"kv_namespaces": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "A map of binding names to KV namespace IDs.",
+		},
+		"r2_buckets": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "A map of binding names to R2 bucket names.",
+		},
+		"d1_databases": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "A map of binding names to D1 database IDs.",
+		},
+		"durable_object_namespaces": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "A map of binding names to Durable Object namespace IDs.",
+		},
+		"compatibility_date": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The date to use for the compatibility flag defaults of the Pages Functions runtime, in `YYYY-MM-DD` format.",
+		},
+		"compatibility_flags": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Compatibility flags to enable for the Pages Functions runtime.",
+		},
+		"placement": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mode": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"smart"}, false),
+						Description:  "The placement mode. The only supported value is `smart`.",
+					},
+				},
+			},
+			Description: "Configures where Pages Functions run relative to the resources they talk to.",
+		},
+	},
+}
+
+func resourceCloudflarePagesProjectSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the Pages project.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"production_branch": {
+			Description: "The name of the branch production deployments are built from.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"source": {
+			Description: "The source repository the project is built from. Omit for projects deployed by direct upload.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"github", "gitlab"}, false),
+						Description:  "The hosting provider of the repository. Valid values: `github`, `gitlab`.",
+					},
+					"config": {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"owner": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "The owner of the repository.",
+								},
+								"repo_name": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "The name of the repository.",
+								},
+								"production_branch": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "The name of the branch production deployments are built from.",
+								},
+								"pr_comments_enabled": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Default:     true,
+									Description: "Whether Pages comments on pull requests.",
+								},
+								"deployments_enabled": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Default:     true,
+									Description: "Whether pushes trigger deployments.",
+								},
+								"preview_deployment_setting": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									Default:      "all",
+									ValidateFunc: validation.StringInSlice([]string{"all", "none", "custom"}, false),
+									Description:  "Which branches get preview deployments: `all`, `none` or `custom`.",
+								},
+								"preview_branch_includes": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Description: "Branches to build preview deployments for when `preview_deployment_setting` is `custom`.",
+								},
+								"preview_branch_excludes": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Description: "Branches to skip preview deployments for when `preview_deployment_setting` is `custom`.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"build_config": {
+			Description: "The build configuration of the project.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"build_command": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The command used to build the project.",
+					},
+					"destination_dir": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The directory the build output is written to, relative to `root_dir`.",
+					},
+					"root_dir": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The directory the build is run in, relative to the repository root.",
+					},
+					"web_analytics_tag": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The Web Analytics site tag injected into built pages.",
+					},
+					"web_analytics_token": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The Web Analytics site token injected into built pages.",
+					},
+				},
+			},
+		},
+		"deployment_configs": {
+			Description: "The per-environment configuration deployments are created with.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"preview": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem:     pagesProjectDeploymentConfigResource,
+					},
+					"production": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem:     pagesProjectDeploymentConfigResource,
+					},
+				},
+			},
+		},
+		"enable_preview_access": {
+			Description: "Whether preview deployments are protected by a Cloudflare Access application covering `*.<subdomain>`. The application is created and removed automatically.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"preview_access_application_id": {
+			Description: "The ID of the Access application protecting preview deployments, for use with `cloudflare_access_policy`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"subdomain": {
+			Description: "The `pages.dev` subdomain the project is served on.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"domains": {
+			Description: "The custom domains attached to the project.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"created_on": {
+			Description: "Timestamp of when the project was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
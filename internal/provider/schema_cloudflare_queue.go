@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareQueueSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the queue.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"delivery_delay": {
+			Description:  "The number of seconds to delay delivery of all messages sent to the queue. Must be between 0 and 43200 (12 hours).",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 43200),
+		},
+		"message_retention_period": {
+			Description:  "The number of seconds messages are retained in the queue. Must be between 60 and 1209600 (14 days). Default: 345600 (4 days).",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      345600,
+			ValidateFunc: validation.IntBetween(60, 1209600),
+		},
+	}
+}
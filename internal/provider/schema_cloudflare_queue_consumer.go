@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareQueueConsumerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"queue_id": {
+			Description: "The ID of the queue to consume from.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"type": {
+			Description:  "The consumer type. A `worker` consumer pushes message batches to a Worker; an `http_pull` consumer is polled over HTTP. Default: `worker`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "worker",
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"worker", "http_pull"}, false),
+		},
+		"script_name": {
+			Description: "The name of the Worker consuming the queue. Required for `worker` consumers.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"environment": {
+			Description: "The name of the Worker environment consuming the queue.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"dead_letter_queue": {
+			Description: "The name of a queue messages are sent to after exhausting `max_retries` deliveries.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"batch_size": {
+			Description:  "The maximum number of messages delivered in a batch. Must be between 1 and 100. Default: 10.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+		"max_retries": {
+			Description:  "The number of times a message is retried after a failed delivery. Must be between 0 and 100. Default: 3.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      3,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+		"retry_delay": {
+			Description: "The number of seconds to delay retried messages for.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+		},
+		"max_wait_time_ms": {
+			Description:  "The maximum number of milliseconds to wait for a batch to fill before delivering it. Only applies to `worker` consumers. Must be between 0 and 30000. Default: 5000.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      5000,
+			ValidateFunc: validation.IntBetween(0, 30000),
+		},
+		"max_concurrency": {
+			Description: "The maximum number of concurrent consumer Worker invocations; omit to let the platform scale automatically. Only applies to `worker` consumers.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+		},
+		"visibility_timeout_ms": {
+			Description: "The number of milliseconds a pulled batch stays invisible to other pullers before it is retried. Only applies to `http_pull` consumers. Default: 30000.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     30000,
+		},
+	}
+}
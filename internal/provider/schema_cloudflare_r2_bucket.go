@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareR2BucketSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the R2 bucket.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"location_hint": {
+			Description:  "A hint for the geographical region to create the bucket in. Valid values: `apac`, `eeur`, `enam`, `oc`, `weur`, `wnam`. Not refreshed from the API; see the computed `location` for where the bucket was placed.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"apac", "eeur", "enam", "oc", "weur", "wnam"}, false),
+		},
+		"jurisdiction": {
+			Description:  "The jurisdiction the bucket and its data are restricted to. Valid values: `default`, `eu`, `fedramp`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "default",
+			ValidateFunc: validation.StringInSlice([]string{"default", "eu", "fedramp"}, false),
+		},
+		"storage_class": {
+			Description:  "The storage class objects are written with unless specified otherwise at upload. Valid values: `Standard`, `InfrequentAccess`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "Standard",
+			ValidateFunc: validation.StringInSlice([]string{"Standard", "InfrequentAccess"}, false),
+		},
+		"location": {
+			Description: "The region the bucket was placed in.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"creation_date": {
+			Description: "Timestamp of when the bucket was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareR2BucketCORSSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"bucket": {
+			Description: "The name of the R2 bucket.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"jurisdiction": {
+			Description:  "The jurisdiction of the bucket. Valid values: `default`, `eu`, `fedramp`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "default",
+			ValidateFunc: validation.StringInSlice([]string{"default", "eu", "fedramp"}, false),
+		},
+		"rule": {
+			Description: "A CORS rule of the bucket.",
+			Type:        schema.TypeList,
+			Required:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The identifier of the rule.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"allowed_origins": {
+						Description: "The origins requests are allowed from.",
+						Type:        schema.TypeList,
+						Required:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"allowed_methods": {
+						Description: "The HTTP methods requests may use.",
+						Type:        schema.TypeList,
+						Required:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"allowed_headers": {
+						Description: "The request headers requests may include.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"expose_headers": {
+						Description: "The response headers browsers are allowed to read.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"max_age_seconds": {
+						Description:  "How long browsers may cache the preflight response, in seconds.",
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareR2BucketEventNotificationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"bucket": {
+			Description: "The name of the R2 bucket.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"queue_id": {
+			Description: "The identifier of the queue the events are sent to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"jurisdiction": {
+			Description:  "The jurisdiction of the bucket. Valid values: `default`, `eu`, `fedramp`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "default",
+			ValidateFunc: validation.StringInSlice([]string{"default", "eu", "fedramp"}, false),
+		},
+		"rule": {
+			Description: "An event notification rule.",
+			Type:        schema.TypeList,
+			Required:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"prefix": {
+						Description: "Only send events for objects whose key starts with this prefix.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"suffix": {
+						Description: "Only send events for objects whose key ends with this suffix.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"actions": {
+						Description: "The object actions that produce events. Valid values: `PutObject`, `CopyObject`, `CompleteMultipartUpload`, `DeleteObject`, `LifecycleDeletion`.",
+						Type:        schema.TypeList,
+						Required:    true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{"PutObject", "CopyObject", "CompleteMultipartUpload", "DeleteObject", "LifecycleDeletion"}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var r2LifecycleConditionSchema = map[string]*schema.Schema{
+	"max_age_seconds": {
+		Description:  "Apply the transition to objects older than this many seconds.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	},
+	"date": {
+		Description: "Apply the transition on this date, in `YYYY-MM-DD` format.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+}
+
+func resourceCloudflareR2BucketLifecycleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"bucket": {
+			Description: "The name of the R2 bucket.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"jurisdiction": {
+			Description:  "The jurisdiction of the bucket. Valid values: `default`, `eu`, `fedramp`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "default",
+			ValidateFunc: validation.StringInSlice([]string{"default", "eu", "fedramp"}, false),
+		},
+		"rule": {
+			Description: "A lifecycle rule of the bucket.",
+			Type:        schema.TypeList,
+			Required:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The identifier of the rule, unique within the bucket.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"enabled": {
+						Description: "Whether the rule is applied.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+					"prefix": {
+						Description: "Only apply the rule to objects whose key starts with this prefix. An empty prefix matches all objects.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"expiration": {
+						Description: "Delete objects matching the condition.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Elem:        &schema.Resource{Schema: r2LifecycleConditionSchema},
+					},
+					"infrequent_access_transition": {
+						Description: "Transition objects matching the condition to the Infrequent Access storage class.",
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Elem:        &schema.Resource{Schema: r2LifecycleConditionSchema},
+					},
+					"abort_multipart_uploads_max_age_seconds": {
+						Description:  "Abort incomplete multipart uploads older than this many seconds.",
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
+	}
+}
@@ -179,6 +179,13 @@ func resourceCloudflareRateLimitSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+
+		"read_only": {
+			Description: "Freeze the legacy rate limit: refuse creates, updates and API deletes, and surface a migration diagnostic with the equivalent `cloudflare_ruleset` configuration on every read.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
 	}
 }
 
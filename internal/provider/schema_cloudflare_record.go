@@ -34,7 +34,7 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 			Type:         schema.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR"}, false),
+			ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS", "SVCB"}, false),
 		},
 
 		"value": {
@@ -273,5 +273,27 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 			Optional: true,
 			Default:  false,
 		},
+
+		"wait_for_propagation": {
+			Description: "Whether to poll the zone's authoritative nameservers after create/update until the record resolves with the expected content. Only applies to `A`, `AAAA`, `CNAME` and `TXT` records.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+
+		"comment": {
+			Description: "Comment for the DNS record.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+
+		"tags": {
+			Description: "Custom tags for the DNS record.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
 	}
 }
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var registrarContactResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"first_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The first name of the contact.",
+		},
+		"last_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The last name of the contact.",
+		},
+		"organization": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The organization of the contact.",
+		},
+		"address": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The street address of the contact.",
+		},
+		"address2": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The second address line of the contact.",
+		},
+		"city": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The city of the contact.",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The state or province of the contact.",
+		},
+		"zip": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The postal code of the contact.",
+		},
+		"country": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile("^[A-Z]{2}$"), "country must be a two-letter uppercase country code"),
+			Description:  "The two-letter country code of the contact.",
+		},
+		"phone": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The phone number of the contact, in `+country.number` format.",
+		},
+		"email": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The email address of the contact.",
+		},
+		"fax": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The fax number of the contact.",
+		},
+	},
+}
+
+func resourceCloudflareRegistrarContactsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"domain": {
+			Description: "The Cloudflare Registrar domain to manage the contacts of.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"registrant": {
+			Description: "The registrant contact of the domain.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Elem:        registrarContactResource,
+		},
+		"admin": {
+			Description: "The administrative contact of the domain. Defaults to the registrant contact.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem:        registrarContactResource,
+		},
+		"tech": {
+			Description: "The technical contact of the domain. Defaults to the registrant contact.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem:        registrarContactResource,
+		},
+	}
+}
@@ -293,6 +293,22 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 													},
 												},
 											},
+											"matched_data": {
+												Type:        schema.TypeList,
+												Optional:    true,
+												MaxItems:    1,
+												Description: "List of properties to configure WAF payload logging for the executed managed ruleset. Takes precedence over the `action_parameters` level `matched_data` so payload logging can differ per managed ruleset.",
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"public_key": {
+															Type:        schema.TypeString,
+															Optional:    true,
+															Sensitive:   true,
+															Description: "Public key to use within WAF Ruleset payload logging to view the HTTP request parameters of the executed managed ruleset.",
+														},
+													},
+												},
+											},
 											"rules": {
 												Type:        schema.TypeList,
 												Optional:    true,
@@ -349,7 +365,8 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 											"public_key": {
 												Type:        schema.TypeString,
 												Optional:    true,
-												Description: "Public key to use within WAF Ruleset payload logging to view the HTTP request parameters. You can generate a public key [using the `matched-data-cli` command-line tool](https://developers.cloudflare.com/waf/managed-rulesets/payload-logging/command-line/generate-key-pair) or [in the Cloudflare dashboard](https://developers.cloudflare.com/waf/managed-rulesets/payload-logging/configure)",
+												Sensitive:   true,
+												Description: "Public key to use within WAF Ruleset payload logging to view the HTTP request parameters. You can generate a public key with the `cloudflare_waf_payload_logging_keypair` resource, [using the `matched-data-cli` command-line tool](https://developers.cloudflare.com/waf/managed-rulesets/payload-logging/command-line/generate-key-pair) or [in the Cloudflare dashboard](https://developers.cloudflare.com/waf/managed-rulesets/payload-logging/configure)",
 											},
 										},
 									},
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareSpeedSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"early_hints": {
+			Description:  "Whether Early Hints are sent while the response is being prepared. Valid values: `on`, `off`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		},
+		"mirage": {
+			Description:  "Whether Mirage accelerates image loading for mobile visitors. Valid values: `on`, `off`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		},
+		"prefetch_preload": {
+			Description:  "Whether URLs in response headers are prefetched. Valid values: `on`, `off`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		},
+		"rocket_loader": {
+			Description:  "Whether Rocket Loader defers JavaScript loading. Valid values: `on`, `off`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		},
+		"speed_brain": {
+			Description:  "Whether Speed Brain prefetches pages the visitor is likely to navigate to. Valid values: `on`, `off`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		},
+	}
+}
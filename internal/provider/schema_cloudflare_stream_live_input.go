@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareStreamLiveInputSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "A name for the live input, stored in its metadata.",
+		},
+		"recording": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mode": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "off",
+						ValidateFunc: validation.StringInSlice([]string{"off", "automatic"}, false),
+						Description:  "Whether the streamed content is recorded: `off` or `automatic`.",
+					},
+					"timeout_seconds": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "The number of seconds to wait for a reconnect before ending the recording.",
+					},
+					"require_signed_urls": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether the recordings can only be played with signed URLs. See `cloudflare_stream_key`.",
+					},
+					"allowed_origins": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "The origins allowed to play back the recordings.",
+					},
+				},
+			},
+			Description: "Configures recording of the streamed content.",
+		},
+		"delete_recording_after_days": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(30),
+			Description:  "The number of days after which recordings of the live input are deleted.",
+		},
+		"rtmps_url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The RTMPS URL to stream to.",
+		},
+		"rtmps_stream_key": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The stream key for the RTMPS ingest endpoint.",
+		},
+		"rtmps_playback_url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The RTMPS URL the stream can be played back from.",
+		},
+		"rtmps_playback_stream_key": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The stream key for the RTMPS playback endpoint.",
+		},
+		"srt_url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The SRT URL to stream to.",
+		},
+		"srt_stream_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The stream ID for the SRT ingest endpoint.",
+		},
+		"srt_passphrase": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The passphrase for the SRT ingest endpoint.",
+		},
+		"web_rtc_url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The WebRTC URL to stream to.",
+		},
+		"web_rtc_playback_url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The WebRTC URL the stream can be played back from.",
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareTeamsCertificateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"certificate": {
+			Description:  "Certificate in PEM format to upload as the TLS inspection root CA. When omitted, Cloudflare generates a certificate for the account.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			RequiredWith: []string{"private_key"},
+		},
+		"private_key": {
+			Description:  "Private key for the uploaded certificate in PEM format.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ForceNew:     true,
+			RequiredWith: []string{"certificate"},
+		},
+		"validity_period_days": {
+			Description:   "Number of days a generated certificate is valid for.",
+			Type:          schema.TypeInt,
+			Optional:      true,
+			Default:       1826,
+			ForceNew:      true,
+			ConflictsWith: []string{"certificate"},
+			ValidateFunc:  validation.IntBetween(1, 10950),
+		},
+		"activate": {
+			Description: "Whether the certificate should be activated for use in TLS inspection.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"certificate_pem": {
+			Description: "Certificate in PEM format, usable for distributing the root CA to endpoints via MDM configuration.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"binding_status": {
+			Description: "Deployment status of the certificate on the edge.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"in_use": {
+			Description: "Whether the certificate is in use by Gateway for TLS inspection.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"issuer_org": {
+			Description: "Organization that issued the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"expires_on": {
+			Description: "RFC3339 timestamp the certificate expires at.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"uploaded_on": {
+			Description: "RFC3339 timestamp the certificate was uploaded at.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -40,16 +40,19 @@ func resourceCloudflareTeamsRuleSchema() map[string]*schema.Schema {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		"traffic": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateTeamsRuleExpression("traffic"),
 		},
 		"identity": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateTeamsRuleExpression("identity"),
 		},
 		"device_posture": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateTeamsRuleExpression("device_posture"),
 		},
 		"version": {
 			Type:     schema.TypeInt,
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var tunnelOriginRequestElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"connect_timeout": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Timeout for establishing a new TCP connection to the origin, e.g. `30s`.",
+		},
+		"tls_timeout": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Timeout for completing a TLS handshake with the origin, e.g. `10s`.",
+		},
+		"tcp_keep_alive": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "TCP keepalive duration for connections to the origin, e.g. `30s`.",
+		},
+		"keep_alive_timeout": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Timeout after which an idle keepalive connection to the origin is closed, e.g. `1m30s`.",
+		},
+		"keep_alive_connections": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Maximum number of idle keepalive connections kept open to the origin.",
+		},
+		"no_happy_eyeballs": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Disable the \"happy eyeballs\" algorithm for IPv4/IPv6 fallback.",
+		},
+		"http_host_header": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "HTTP Host header to set on requests to the origin.",
+		},
+		"origin_server_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Hostname expected on the origin server certificate.",
+		},
+		"ca_pool": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Path to the CA certificate used to validate the origin certificate, for origins not signed by Cloudflare.",
+		},
+		"no_tls_verify": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Disable TLS verification of the certificate presented by the origin.",
+		},
+		"disable_chunked_encoding": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Disable chunked transfer encoding, useful for WSGI origins.",
+		},
+		"bastion_mode": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Run as a jump host.",
+		},
+		"proxy_address": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Listen address for the proxy.",
+		},
+		"proxy_port": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Listen port for the proxy.",
+		},
+		"proxy_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"", "socks"}, false),
+			Description:  "The proxy type. Valid values: `\"\"` or `socks`.",
+		},
+		"ip_rule": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "IP rules for the proxy service.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"prefix": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"ports": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeInt},
+					},
+					"allow": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+	},
+}
+
+func resourceCloudflareTunnelCloudflaredConfigSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"tunnel_id": {
+			Description: "The identifier of the tunnel the configuration belongs to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"config": {
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ingress_rule": {
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						Description: "The ingress rules of the tunnel, evaluated in order. The last rule must be a catch-all without a hostname.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"hostname": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "The hostname to match on. Leave empty on the final catch-all rule.",
+								},
+								"path": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "A regular expression the request path must match in addition to the hostname.",
+								},
+								"service": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "The service the matched traffic is proxied to, e.g. `http://localhost:8000`, `tcp://localhost:22` or `http_status:404`.",
+								},
+							},
+						},
+					},
+					"warp_routing": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"enabled": {
+									Type:     schema.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+							},
+						},
+						Description: "Configures WARP routing (private network access) through the tunnel.",
+					},
+					"origin_request": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Elem:        tunnelOriginRequestElem,
+						Description: "Configures how cloudflared sends requests to the origin services.",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareWorkerDomainSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"zone_id": {
+			Description: "The zone identifier of the zone the hostname belongs to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"hostname": {
+			Description: "The hostname of the Worker custom domain.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"service": {
+			Description: "The name of the Worker to serve on the hostname.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"environment": {
+			Description: "The name of the Worker environment to serve on the hostname.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "production",
+		},
+	}
+}
@@ -1,6 +1,9 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 func resourceCloudflareWorkerKVSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -17,5 +20,17 @@ func resourceCloudflareWorkerKVSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Required: true,
 		},
+		"metadata": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			Description:  "Arbitrary JSON metadata to store with the key, returned alongside the key when the namespace is listed.",
+		},
+		"expiration_ttl": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(60),
+			Description:  "The number of seconds the key should live for before expiring. Must be at least 60.",
+		},
 	}
 }
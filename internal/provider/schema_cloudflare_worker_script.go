@@ -1,6 +1,11 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 var kvNamespaceBindingResource = &schema.Resource{
 	Schema: map[string]*schema.Schema{
@@ -55,6 +60,129 @@ var webAssemblyBindingResource = &schema.Resource{
 	},
 }
 
+var sourceMapResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The file name the source map is uploaded under, e.g. `worker.js.map`.",
+		},
+		"content": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The source map contents.",
+		},
+	},
+}
+
+var r2BucketBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"bucket_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	},
+}
+
+var d1DatabaseBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"database_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	},
+}
+
+var queueBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"queue": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	},
+}
+
+var durableObjectNamespaceBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"class_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"script_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"environment": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	},
+}
+
+var analyticsEngineBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"dataset": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	},
+}
+
+var additionalModuleResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The file name the module is imported under, e.g. `lib.mjs`.",
+		},
+		"content": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The module contents; base64-encoded for `wasm` and `data` modules.",
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "esm",
+			ValidateFunc: validation.StringInSlice([]string{"esm", "commonjs", "text", "data", "wasm"}, false),
+			Description:  "The module type. Valid values: `esm`, `commonjs`, `text`, `data`, `wasm`.",
+		},
+	},
+}
+
+var dispatchNamespaceBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"namespace": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	},
+}
+
 var serviceBindingResource = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"name": {
@@ -83,6 +211,48 @@ func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Required: true,
 		},
+		"content_sha256": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9a-f]{64}$"), "digest must be 64 lowercase hex characters"),
+			Description:  "SHA-256 hex digest of the script content and modules. When set, the digest replaces the raw content as the change signal for uploads: content changes are ignored while the digest stays the same.",
+		},
+		"dispatch_namespace": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The Workers for Platforms dispatch namespace to upload the script into, instead of the account-level namespace.",
+		},
+		"workers_dev": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether the script is served on the account's `workers.dev` subdomain.",
+		},
+		"preview_urls": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether preview URLs are generated for the script.",
+		},
+		"module": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    true,
+			Description: "Whether the script uses module syntax; `content` is then uploaded as the main module instead of a service worker body.",
+		},
+		"compatibility_date": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The date to use for the compatibility flag defaults of the Workers runtime, in `YYYY-MM-DD` format.",
+		},
+		"compatibility_flags": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Compatibility flags to enable for the Workers runtime, on top of the `compatibility_date` defaults.",
+		},
 		"plain_text_binding": {
 			Type:     schema.TypeSet,
 			Optional: true,
@@ -108,5 +278,89 @@ func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 			Optional: true,
 			Elem:     serviceBindingResource,
 		},
+		"r2_bucket_binding": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     r2BucketBindingResource,
+		},
+		"d1_database_binding": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     d1DatabaseBindingResource,
+		},
+		"queue_binding": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     queueBindingResource,
+		},
+		"durable_object_namespace_binding": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     durableObjectNamespaceBindingResource,
+		},
+		"analytics_engine_binding": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     analyticsEngineBindingResource,
+		},
+		"dispatch_namespace_binding": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        dispatchNamespaceBindingResource,
+			Description: "Binds a Workers for Platforms dispatch namespace to the script, allowing it to dispatch requests to user Workers in the namespace.",
+		},
+		"logpush": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether Workers Trace Events Logpush is enabled for the script.",
+		},
+		"tail_consumers": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"service": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The name of the Worker consuming the tail events.",
+					},
+					"environment": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The name of the Worker environment consuming the tail events.",
+					},
+				},
+			},
+			Description: "Workers that consume the trace events of this script.",
+		},
+		"placement": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mode": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"smart"}, false),
+						Description:  "The placement mode. The only supported value is `smart`.",
+					},
+				},
+			},
+			Description: "Configures where the script runs relative to the resources it talks to.",
+		},
+		"additional_module": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        additionalModuleResource,
+			Description: "Additional modules uploaded alongside the main module of a module-syntax worker.",
+		},
+		"source_map": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        sourceMapResource,
+			Description: "Source maps to upload alongside the script so error traces resolve to the original sources.",
+		},
 	}
 }
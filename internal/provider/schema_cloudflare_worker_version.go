@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceCloudflareWorkerVersionSchema derives from the worker_script
+// schema: a version carries the same upload payload as a script, but is
+// immutable once uploaded and has no serving settings of its own.
+func resourceCloudflareWorkerVersionSchema() map[string]*schema.Schema {
+	s := resourceCloudflareWorkerScriptSchema()
+
+	// serving settings belong to the script, not to individual versions
+	delete(s, "workers_dev")
+	delete(s, "preview_urls")
+	delete(s, "content_sha256")
+
+	// versions are only supported for account-level scripts
+	delete(s, "dispatch_namespace")
+
+	// the script name moves to script_name so the version keeps its own
+	// identifiers
+	delete(s, "name")
+
+	// versions are immutable; any change uploads a new one
+	for _, attribute := range s {
+		attribute.ForceNew = true
+	}
+
+	s["script_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the worker script the version belongs to.",
+	}
+	s["message"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Message annotation attached to the version, shown in the dashboard version history.",
+	}
+	s["number"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The sequential number the API assigned to the version.",
+	}
+
+	return s
+}
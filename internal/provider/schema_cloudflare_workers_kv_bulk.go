@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareWorkersKVBulkSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"namespace_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The ID of the Workers KV namespace the pairs are written into.",
+		},
+		"pairs": {
+			Type:        schema.TypeMap,
+			Required:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "A map of keys to the values to store under them.",
+		},
+		"expiration_ttl": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(60),
+			Description:  "The number of seconds each written key should live for before expiring. Must be at least 60.",
+		},
+	}
+}
@@ -35,11 +35,12 @@ func resourceCloudflareZoneSchema() map[string]*schema.Schema {
 		},
 		"vanity_name_servers": {
 			Type:     schema.TypeList,
+			Optional: true,
 			Computed: true,
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
-			Description: "List of Vanity Nameservers (if set).",
+			Description: "List of Vanity Nameservers (if set). Requires custom nameservers to be configured on the account.",
 		},
 		"plan": {
 			Type:     schema.TypeString,
@@ -81,10 +82,10 @@ func resourceCloudflareZoneSchema() map[string]*schema.Schema {
 		},
 		"type": {
 			Type:         schema.TypeString,
-			ValidateFunc: validation.StringInSlice([]string{"full", "partial"}, false),
+			ValidateFunc: validation.StringInSlice([]string{"full", "partial", "secondary"}, false),
 			Default:      "full",
 			Optional:     true,
-			Description:  fmt.Sprintf("A full zone implies that DNS is hosted with Cloudflare. A partial zone is typically a partner-hosted zone or a CNAME setup. %s", renderAvailableDocumentationValuesStringSlice([]string{"full", "partial"})),
+			Description:  fmt.Sprintf("A full zone implies that DNS is hosted with Cloudflare. A partial zone is typically a partner-hosted zone or a CNAME setup. A secondary zone is transferred in from external primary nameservers. %s", renderAvailableDocumentationValuesStringSlice([]string{"full", "partial", "secondary"})),
 		},
 		"name_servers": {
 			Type:     schema.TypeList,
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareZoneCustomBotCategorySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the custom bot category.",
+		},
+		"verified_bot_override": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether traffic matching the category is treated as a verified bot, exempting it from Bot Fight Mode and `cf.bot_management.verified_bot` rules.",
+		},
+		"ja3_fingerprints": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9a-f]{32}$"), "JA3 fingerprints are 32 lowercase hex characters"),
+			},
+			Description: "JA3 TLS fingerprints allowlisted by the category.",
+		},
+		"ja4_fingerprints": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[a-z0-9]+_[0-9a-f]+_[0-9a-f]+$"), "JA4 fingerprints have three underscore-separated parts, e.g. t13d1516h2_8daaf6152771_02713d6af862"),
+			},
+			Description: "JA4 TLS fingerprints allowlisted by the category.",
+		},
+	}
+}
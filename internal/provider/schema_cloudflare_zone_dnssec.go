@@ -42,6 +42,43 @@ func resourceCloudflareZoneDNSSECSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"ds_record": {
+			Description: "The components of the zone's DS record in structured form, for feeding registrar automation.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key_tag": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"algorithm": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"digest_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"digest": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"dnssec_multi_signer": {
+			Description: "Whether multi-signer DNSSEC is enabled, allowing multiple providers to serve the zone with their own DNSSEC keys.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"dnssec_presigned": {
+			Description: "Whether the zone's DNSSEC records are presigned and transferred from an external provider instead of signed by Cloudflare.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
 		"key_tag": {
 			Type:     schema.TypeInt,
 			Computed: true,
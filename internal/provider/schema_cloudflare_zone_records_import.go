@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareZoneRecordsImportSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+
+		"zone_file": {
+			Description: "The contents of a BIND-format zone file holding the records to import.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+
+		"overwrite_existing": {
+			Description: "Whether records that already exist in the zone with different content may be overwritten. Without this the import fails when it finds a conflicting record.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+
+		"proxied": {
+			Description: "Whether the imported records receive Cloudflare's origin protection.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+
+		"delete_records_on_destroy": {
+			Description: "Whether destroying this resource also deletes the records it created. By default the records are left in place.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+
+		"record_ids": {
+			Description: "A mapping of each imported record to its identifier in the API.",
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+
+		"total_records": {
+			Description: "The number of records managed by this import.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	}
+}
@@ -528,4 +528,14 @@ var resourceCloudflareZoneSettingsSchema = map[string]*schema.Schema{
 		Optional:     true,
 		Computed:     true,
 	},
+
+	"additional_settings": {
+		Type:     schema.TypeMap,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: "String-valued settings without a named attribute, keyed by the setting name the API uses, e.g. `speed_brain` or `fonts`. Keys are validated at apply time against the settings the API reports for the zone, so newly released settings can be managed without a provider update.",
+	},
 }
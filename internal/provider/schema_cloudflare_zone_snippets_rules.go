@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareZoneSnippetsRulesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+
+		"rules": {
+			Description: "The rules routing requests to snippets, evaluated in order.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"expression": {
+						Description: "The expression defining which requests run the snippet.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"snippet_name": {
+						Description: "The name of the snippet to run. Each snippet can only be referenced by one rule per zone.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"description": {
+						Description: "Brief summary of the snippet rule and its intended use.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"enabled": {
+						Description: "Whether the snippet rule is active.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -2,15 +2,25 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"hash/crc32"
+	"io"
+	"io/ioutil"
 	"log"
+	"net"
+	"net/http"
+	"os"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
+	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -251,3 +261,166 @@ func renderAvailableDocumentationValuesStringSlice(s []string) string {
 	}
 	return output
 }
+
+// doAuthenticatedHTTPRequest performs a hand-built request against the API
+// using the client's base URL and credentials. It is used for endpoints that
+// client.Raw (JSON only) cannot express, such as multipart uploads and raw
+// downloads.
+func doAuthenticatedHTTPRequest(ctx context.Context, client *cloudflare.API, method, endpoint, contentType string, body io.Reader) (*http.Response, error) {
+	return doAuthenticatedHTTPRequestWithHeaders(ctx, client, method, endpoint, contentType, nil, body)
+}
+
+// doAuthenticatedHTTPRequestWithHeaders behaves like
+// doAuthenticatedHTTPRequest but also sets the given request headers, for
+// endpoints that take parameters via headers (such as the R2 jurisdiction).
+func doAuthenticatedHTTPRequestWithHeaders(ctx context.Context, client *cloudflare.API, method, endpoint, contentType string, headers map[string]string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, client.BaseURL+endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	switch {
+	case client.APIToken != "":
+		req.Header.Set("Authorization", "Bearer "+client.APIToken)
+	case client.APIUserServiceKey != "":
+		req.Header.Set("X-Auth-User-Service-Key", client.APIUserServiceKey)
+	default:
+		req.Header.Set("X-Auth-Email", client.APIEmail)
+		req.Header.Set("X-Auth-Key", client.APIKey)
+	}
+
+	return http.DefaultClient.Do(req)
+}
+
+// conditionalRequestCacheEntry remembers the ETag and result of a previous
+// GET response for an endpoint.
+type conditionalRequestCacheEntry struct {
+	etag   string
+	result json.RawMessage
+}
+
+// conditionalRequestCacheMaxEntries bounds the cache so a long-lived process
+// refreshing many endpoints does not grow it without limit.
+const conditionalRequestCacheMaxEntries = 1024
+
+var (
+	conditionalRequestCache   = map[string]conditionalRequestCacheEntry{}
+	conditionalRequestCacheMu sync.Mutex
+)
+
+// rawConditionalGet behaves like client.Raw with the GET method, but sends
+// If-None-Match when a previous response for the endpoint carried an ETag and
+// reuses the cached result on a 304 Not Modified. This cuts refresh time for
+// large workspaces where many resources read the same endpoint, and lets the
+// API skip re-rendering unchanged payloads. Endpoints without ETags behave as
+// before; writes need no invalidation because a stale ETag simply no longer
+// matches.
+func rawConditionalGet(ctx context.Context, client *cloudflare.API, endpoint string) (json.RawMessage, error) {
+	// Cache entries are keyed by base URL and endpoint so provider aliases
+	// pointed at different API hosts never serve each other's payloads.
+	cacheKey := client.BaseURL + endpoint
+
+	conditionalRequestCacheMu.Lock()
+	cached, ok := conditionalRequestCache[cacheKey]
+	conditionalRequestCacheMu.Unlock()
+
+	var headers map[string]string
+	if ok && cached.etag != "" {
+		headers = map[string]string{"If-None-Match": cached.etag}
+	}
+
+	res, err := doAuthenticatedHTTPRequestWithHeaders(ctx, client, http.MethodGet, endpoint, "", headers, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotModified {
+		return cached.result, nil
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var envelope struct {
+		Success bool `json:"success"`
+		Errors  []struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"errors"`
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("HTTP status %d: %s", res.StatusCode, string(body))
+	}
+
+	if !envelope.Success {
+		errMsg := ""
+		if len(envelope.Errors) > 0 {
+			errMsg = envelope.Errors[0].Message
+		}
+		return nil, fmt.Errorf("HTTP status %d: %s", res.StatusCode, errMsg)
+	}
+
+	if etag := res.Header.Get("ETag"); etag != "" {
+		conditionalRequestCacheMu.Lock()
+		if _, exists := conditionalRequestCache[cacheKey]; !exists && len(conditionalRequestCache) >= conditionalRequestCacheMaxEntries {
+			// Evict an arbitrary entry; a miss only costs one uncached GET.
+			for key := range conditionalRequestCache {
+				delete(conditionalRequestCache, key)
+				break
+			}
+		}
+		conditionalRequestCache[cacheKey] = conditionalRequestCacheEntry{etag: etag, result: envelope.Result}
+		conditionalRequestCacheMu.Unlock()
+	}
+
+	return envelope.Result, nil
+}
+
+// isRequestTimeoutError reports whether err looks like the request never
+// produced an API response: the HTTP client timed out, the context deadline
+// expired or the connection dropped mid flight. In those cases the API may
+// still have processed the request, so callers creating named objects should
+// re-check for the object before treating the create as failed.
+func isRequestTimeoutError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return strings.Contains(err.Error(), "Client.Timeout exceeded")
+}
+
+// ResourceNamePrefix returns the prefix applied to the names of resources
+// created by the acceptance tests. Setting CLOUDFLARE_RESOURCE_NAME_PREFIX
+// gives each concurrent CI run against a shared account its own namespace so
+// the runs do not collide. The prefix is lowercased and restricted to
+// alphanumerics and dashes so it stays valid in hostnames and resource names.
+func ResourceNamePrefix() string {
+	var prefix strings.Builder
+	for _, c := range strings.ToLower(os.Getenv("CLOUDFLARE_RESOURCE_NAME_PREFIX")) {
+		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' {
+			prefix.WriteRune(c)
+		}
+	}
+	return prefix.String()
+}
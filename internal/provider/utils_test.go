@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"os"
+	"testing"
+)
+
+func TestResourceNamePrefix(t *testing.T) {
+	tests := map[string]string{
+		"":            "",
+		"run-1":       "run-1",
+		"Run_2!":      "run2",
+		"ci.run/3":    "cirun3",
+		"tf-acc-ci-b": "tf-acc-ci-b",
+	}
+
+	original := os.Getenv("CLOUDFLARE_RESOURCE_NAME_PREFIX")
+	defer os.Setenv("CLOUDFLARE_RESOURCE_NAME_PREFIX", original)
+
+	for input, expected := range tests {
+		os.Setenv("CLOUDFLARE_RESOURCE_NAME_PREFIX", input)
+		if actual := ResourceNamePrefix(); actual != expected {
+			t.Errorf("expected ResourceNamePrefix of %q to be %q, got %q", input, expected, actual)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -15,13 +16,13 @@ func validateRecordType(t string, proxied bool) error {
 	switch t {
 	case "A", "AAAA", "CNAME":
 		return nil
-	case "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CAA", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR":
+	case "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CAA", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS", "SVCB":
 		if ![]bool{proxied}[0] {
 			return nil
 		}
 	default:
 		return fmt.Errorf(
-			`Invalid type %q. Valid types are "A", "AAAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CAA", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI" or "PTR.`, t)
+			`Invalid type %q. Valid types are "A", "AAAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CAA", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS" or "SVCB.`, t)
 	}
 
 	return fmt.Errorf("type %q cannot be proxied", t)
@@ -74,3 +75,172 @@ func validateURL(v interface{}, k string) (s []string, errors []error) {
 	}
 	return
 }
+
+// knownSvcParamKeys are the SvcParamKeys understood for HTTPS/SVCB records.
+var knownSvcParamKeys = []string{"alpn", "no-default-alpn", "port", "ipv4hint", "ipv6hint", "ech", "mandatory", "dohpath"}
+
+// validateSvcParams checks the free-form SvcParams portion (`value`) of an
+// HTTPS or SVCB record so malformed parameters are caught before the apply.
+func validateSvcParams(params string) error {
+	for _, param := range strings.Fields(params) {
+		key := param
+		value := ""
+		if idx := strings.Index(param, "="); idx > -1 {
+			key, value = param[:idx], param[idx+1:]
+		}
+
+		if !contains(knownSvcParamKeys, key) {
+			return fmt.Errorf("unknown SvcParamKey %q; expected one of %s", key, strings.Join(knownSvcParamKeys, ", "))
+		}
+
+		switch key {
+		case "no-default-alpn":
+			if value != "" {
+				return fmt.Errorf("SvcParamKey %q does not take a value", key)
+			}
+		case "port":
+			if value == "" {
+				return fmt.Errorf("SvcParamKey %q requires a value", key)
+			}
+			if _, err := strconv.ParseUint(value, 10, 16); err != nil {
+				return fmt.Errorf("SvcParamKey %q requires a port number between 0 and 65535, got %q", key, value)
+			}
+		case "ipv4hint", "ipv6hint":
+			for _, hint := range strings.Split(strings.Trim(value, `"`), ",") {
+				ip := net.ParseIP(hint)
+				if ip == nil {
+					return fmt.Errorf("SvcParamKey %q contains an invalid IP: %q", key, hint)
+				}
+				if key == "ipv4hint" && ip.To4() == nil {
+					return fmt.Errorf("SvcParamKey %q contains a non-IPv4 address: %q", key, hint)
+				}
+				if key == "ipv6hint" && ip.To4() != nil {
+					return fmt.Errorf("SvcParamKey %q contains a non-IPv6 address: %q", key, hint)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateTeamsRuleExpression returns a validator for the given Gateway rule
+// expression attribute so malformed wirefilter expressions are caught at plan
+// time rather than by the API.
+func validateTeamsRuleExpression(ruletype string) func(interface{}, string) ([]string, []error) {
+	return func(v interface{}, k string) (warnings []string, errors []error) {
+		if err := checkTeamsRuleExpression(ruletype, v.(string)); err != nil {
+			errors = append(errors, fmt.Errorf("%q: %w", k, err))
+		}
+		return
+	}
+}
+
+func isTeamsRuleExpressionIdentChar(c byte) bool {
+	return c == '_' || c == '.' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+// checkTeamsRuleExpression performs a light syntactic check of a Gateway
+// wirefilter expression: delimiters must balance and every dotted field
+// reference must be valid for the given ruletype. It deliberately does not
+// implement the full wirefilter grammar; the API remains the authority on
+// whether an expression is accepted.
+func checkTeamsRuleExpression(ruletype, expression string) error {
+	fields := teamsRuleExpressionFields[ruletype]
+
+	var parens, brackets, braces int
+	inString := false
+	for i := 0; i < len(expression); i++ {
+		c := expression[i]
+
+		if inString {
+			switch c {
+			case '\\':
+				i++
+			case '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '(':
+			parens++
+		case ')':
+			parens--
+		case '[':
+			brackets++
+		case ']':
+			brackets--
+		case '{':
+			braces++
+		case '}':
+			braces--
+		default:
+			if !isTeamsRuleExpressionIdentChar(c) {
+				continue
+			}
+
+			j := i
+			for j < len(expression) && isTeamsRuleExpressionIdentChar(expression[j]) {
+				j++
+			}
+			word := expression[i:j]
+			i = j - 1
+
+			// numeric and IP literals also contain dots; only words that
+			// start with a letter are field references
+			if !strings.Contains(word, ".") || word[0] == '.' || ('0' <= word[0] && word[0] <= '9') {
+				continue
+			}
+
+			if !teamsRuleExpressionFieldValid(fields, word) {
+				return fmt.Errorf("unknown field %q; the cloudflare_teams_rule_fields data source lists the fields valid in %s expressions", word, ruletype)
+			}
+		}
+
+		if parens < 0 || brackets < 0 || braces < 0 {
+			return fmt.Errorf("expression has unbalanced delimiters")
+		}
+	}
+
+	if inString {
+		return fmt.Errorf("expression has an unterminated string literal")
+	}
+	if parens != 0 || brackets != 0 || braces != 0 {
+		return fmt.Errorf("expression has unbalanced delimiters")
+	}
+
+	return nil
+}
+
+func teamsRuleExpressionFieldValid(fields []string, name string) bool {
+	for _, field := range fields {
+		if name == field || strings.HasPrefix(name, field+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// validateHTTPSData validates the `data` block of an HTTPS or SVCB record.
+func validateHTTPSData(recordType string, data map[string]interface{}) error {
+	if recordType != "HTTPS" && recordType != "SVCB" {
+		return nil
+	}
+
+	target, ok := data["target"].(string)
+	if !ok || target == "" {
+		return fmt.Errorf("%s records require `data.target` (use \".\" for the record name itself)", recordType)
+	}
+
+	if value, ok := data["value"].(string); ok && value != "" {
+		if err := validateSvcParams(value); err != nil {
+			return fmt.Errorf("invalid SvcParams for %s record: %w", recordType, err)
+		}
+	}
+
+	return nil
+}
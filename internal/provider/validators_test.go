@@ -67,3 +67,60 @@ func TestValidateRecordName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateSvcParams(t *testing.T) {
+	validParams := []string{
+		`alpn="h3,h2" ipv4hint="127.0.0.1" ipv6hint="::1"`,
+		"no-default-alpn port=8443",
+		"",
+	}
+
+	for _, params := range validParams {
+		if err := validateSvcParams(params); err != nil {
+			t.Fatalf("%q should be valid SvcParams: %s", params, err)
+		}
+	}
+
+	invalidParams := []string{
+		"bogus=1",
+		`ipv4hint="not-an-ip"`,
+		`ipv4hint="2001:db8::1"`,
+		`ipv6hint="192.0.2.1"`,
+		"no-default-alpn=true",
+		"port",
+		"port=abc",
+		"port=70000",
+	}
+
+	for _, params := range invalidParams {
+		if err := validateSvcParams(params); err == nil {
+			t.Fatalf("%q should be invalid SvcParams", params)
+		}
+	}
+}
+
+func TestCheckTeamsRuleExpression(t *testing.T) {
+	validExpressions := map[string]string{
+		"traffic":        `any(dns.domains[*] == "example.com") and dns.src.ip in {10.0.0.0/8 192.168.0.1}`,
+		"identity":       `identity.email matches ".*@example\\.com" or any(identity.groups.name[*] == "admins")`,
+		"device_posture": `any(device_posture.checks.passed[*] in {"ddf45c08-6d27-415a-9a0a-4b9b4a66e0ed"})`,
+	}
+
+	for ruletype, expression := range validExpressions {
+		if err := checkTeamsRuleExpression(ruletype, expression); err != nil {
+			t.Fatalf("%q should be a valid %s expression: %s", expression, ruletype, err)
+		}
+	}
+
+	invalidExpressions := map[string]string{
+		"traffic":        `http.request.bogus == "example.com"`,
+		"identity":       `identity.email == "user@example.com`,
+		"device_posture": `any(device_posture.checks.passed[*] == "check"`,
+	}
+
+	for ruletype, expression := range invalidExpressions {
+		if err := checkTeamsRuleExpression(ruletype, expression); err == nil {
+			t.Fatalf("%q should be an invalid %s expression", expression, ruletype)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"sync"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+)
+
+// serializeZoneWritesClients records, per configured client, whether
+// `serialize_zone_writes` is enabled. Keeping the setting per provider
+// instance lets aliased provider blocks opt in independently without one
+// alias overriding another.
+var (
+	serializeZoneWritesClients   = map[*cloudflare.API]bool{}
+	serializeZoneWritesClientsMu sync.Mutex
+)
+
+var (
+	zoneWriteLocks   = map[string]*sync.Mutex{}
+	zoneWriteLocksMu sync.Mutex
+)
+
+// setSerializeZoneWrites records the `serialize_zone_writes` provider setting
+// for the given client.
+func setSerializeZoneWrites(client *cloudflare.API, enabled bool) {
+	serializeZoneWritesClientsMu.Lock()
+	serializeZoneWritesClients[client] = enabled
+	serializeZoneWritesClientsMu.Unlock()
+}
+
+// lockZoneWrites serializes write operations against the given zone when the
+// client's provider instance is configured with `serialize_zone_writes`. The
+// returned function releases the lock and must always be called; when
+// serialization is disabled both the lock and the release are no-ops. Locks
+// are keyed by zone so aliases writing to the same zone serialize with each
+// other while cross-zone parallelism stays intact.
+func lockZoneWrites(client *cloudflare.API, zoneID string) func() {
+	serializeZoneWritesClientsMu.Lock()
+	enabled := serializeZoneWritesClients[client]
+	serializeZoneWritesClientsMu.Unlock()
+
+	if !enabled || zoneID == "" {
+		return func() {}
+	}
+
+	zoneWriteLocksMu.Lock()
+	lock, ok := zoneWriteLocks[zoneID]
+	if !ok {
+		lock = &sync.Mutex{}
+		zoneWriteLocks[zoneID] = lock
+	}
+	zoneWriteLocksMu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}